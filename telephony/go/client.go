@@ -2,14 +2,18 @@ package telephony
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"golang.org/x/time/rate"
 )
 
 // Call represents the state of a SIP call.
@@ -22,6 +26,11 @@ type Call struct {
 	Channel   string `json:"channel"`
 	UID       string `json:"uid"`
 	AppID     string `json:"appid,omitempty"`
+	// HangupReason is set on calls delivered to OnCallHangup that the client
+	// synthesized itself rather than received directly from the gateway —
+	// e.g. ReasonServerLost after a reconnect resync. Empty for a normal,
+	// gateway-reported hangup.
+	HangupReason HangupReason `json:"hangup_reason,omitempty"`
 }
 
 // EventHandler is the interface that consumers implement to receive call events.
@@ -42,6 +51,16 @@ type DTMFHandler interface {
 	OnDTMFReceived(call *Call, digits string)
 }
 
+// ReconnectHandler is an optional interface for observing automatic
+// reconnects. Implement this on your EventHandler to receive OnReconnect
+// callbacks after the client re-establishes a dropped connection and
+// completes its post-reconnect resync. resumed reports whether the server
+// recognized the echoed resume token and continued the prior session, as
+// opposed to starting a fresh one.
+type ReconnectHandler interface {
+	OnReconnect(sessionID string, resumed bool)
+}
+
 // DialParams contains parameters for placing an outbound call.
 type DialParams struct {
 	To        string `json:"to"`
@@ -54,6 +73,13 @@ type DialParams struct {
 	Sip       string `json:"sip,omitempty"`
 	SipDomain string `json:"sip_domain,omitempty"`
 	AppID     string `json:"appid,omitempty"`
+	// AutoTimeout derives Timeout from ctx's deadline instead — Dial
+	// overwrites whatever Timeout is set with the context's remaining time,
+	// rounded down to the second. Sending Dial's ctx through
+	// context.WithTimeout and leaving Timeout empty has the same effect;
+	// this flag is for when Timeout is also set (e.g. loaded from a Job)
+	// and the context should win.
+	AutoTimeout bool `json:"-"`
 }
 
 // DialResult contains the response from a Dial request.
@@ -86,17 +112,32 @@ type BridgeParams struct {
 
 // Client is the Telephony WebSocket SDK client.
 type Client struct {
-	wsURL            string
-	authToken        string
-	clientID         string
-	appID            string
-	subscribeNumbers []string
-	conn             *websocket.Conn
-	calls            map[string]*Call // callid -> call state
-	mu               sync.RWMutex    // protects calls, conn, handler
-	handler          EventHandler
-	connected        atomic.Bool
-	done             chan struct{}
+	wsURL                string
+	authToken            string
+	clientID             string
+	appID                string
+	subscribeNumbers     []string
+	conn                 *websocket.Conn
+	calls                map[string]*Call // callid -> call state
+	mu                   sync.RWMutex     // protects calls, conn, handler
+	handler              EventHandler
+	logger               Logger
+	establishLimiter     *rate.Limiter
+	inCallLimiter        *rate.Limiter
+	tlsConfig            *tls.Config
+	netDialContext       func(ctx context.Context, network, addr string) (net.Conn, error)
+	transport            Transport
+	noEnvProxy           bool
+	usingProxy           atomic.Bool
+	resumeToken          string
+	sessionID            string
+	lastResumed          bool
+	lastSeq              atomic.Int64
+	maxReconnectAttempts int
+	connected            atomic.Bool
+	state                *connectivityStateMgr
+	done                 chan struct{}
+	eventsCh             chan Event
 
 	// pending responses keyed by request_id
 	pendingMu sync.Mutex
@@ -105,19 +146,30 @@ type Client struct {
 
 	// writeMu serializes WS writes — gorilla/websocket doesn't support concurrent writers
 	writeMu sync.Mutex
+
+	// interceptorMu guards commandInterceptors/eventInterceptors
+	interceptorMu       sync.RWMutex
+	commandInterceptors []CommandInterceptor
+	eventInterceptors   []EventInterceptor
 }
 
 // NewClient creates a new Telephony WebSocket client.
-func NewClient(wsURL, authToken, clientID, appID string) *Client {
-	return &Client{
+func NewClient(wsURL, authToken, clientID, appID string, opts ...ClientOption) *Client {
+	c := &Client{
 		wsURL:     wsURL,
 		authToken: authToken,
 		clientID:  clientID,
 		appID:     appID,
 		calls:     make(map[string]*Call),
+		state:     newConnectivityStateMgr(),
+		logger:    NopLogger{},
 		done:      make(chan struct{}),
 		pending:   make(map[string]chan map[string]interface{}),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // SetHandler sets the event handler for receiving call events.
@@ -134,6 +186,24 @@ func (c *Client) getHandler() EventHandler {
 	return c.handler
 }
 
+// SetLogger sets the Logger used for internal diagnostic logging. Safe to
+// call at any time, including concurrently with an active connection.
+func (c *Client) SetLogger(l Logger) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.logger = l
+}
+
+// getLogger returns the current logger under read lock.
+func (c *Client) getLogger() Logger {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.logger == nil {
+		return NopLogger{}
+	}
+	return c.logger
+}
+
 // getConn returns the current websocket connection under read lock.
 func (c *Client) getConn() *websocket.Conn {
 	c.mu.RLock()
@@ -141,20 +211,43 @@ func (c *Client) getConn() *websocket.Conn {
 	return c.conn
 }
 
-// SetSubscribeNumbers sets the phone numbers to subscribe to for inbound call filtering.
-// Numbers are sent to the server during Connect(). Call before Connect().
+// sessionInfo returns the most recently registered session ID and whether
+// that registration resumed a prior session (as opposed to starting fresh).
+func (c *Client) sessionInfo() (sessionID string, resumed bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.sessionID, c.lastResumed
+}
+
+// SetSubscribeNumbers sets the phone numbers to subscribe to for inbound
+// call filtering. Numbers are sent with every register message, including
+// on reconnect, so a dropped connection doesn't lose the subscription.
 func (c *Client) SetSubscribeNumbers(numbers []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.subscribeNumbers = numbers
 }
 
+// getSubscribeNumbers returns the current subscription list under read lock.
+func (c *Client) getSubscribeNumbers() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.subscribeNumbers
+}
+
 // Connect dials the WebSocket server and sends a register message.
 func (c *Client) Connect(ctx context.Context) error {
+	c.state.set(Connecting)
+
 	dialer := websocket.Dialer{
 		HandshakeTimeout: 10 * time.Second,
+		NetDialContext:   c.resolveDialContext(),
+		TLSClientConfig:  c.tlsConfig,
 	}
 
 	conn, _, err := dialer.DialContext(ctx, c.wsURL, nil)
 	if err != nil {
+		c.state.set(TransientFailure)
 		return fmt.Errorf("ws dial failed: %w", err)
 	}
 
@@ -162,21 +255,31 @@ func (c *Client) Connect(ctx context.Context) error {
 	_, msg, err := conn.ReadMessage()
 	if err != nil {
 		conn.Close()
+		c.state.set(TransientFailure)
 		return fmt.Errorf("failed to read connected message: %w", err)
 	}
 
 	var connMsg map[string]interface{}
 	if err := json.Unmarshal(msg, &connMsg); err != nil {
 		conn.Close()
+		c.state.set(TransientFailure)
 		return fmt.Errorf("invalid connected message: %w", err)
 	}
 
 	if connMsg["status"] != "connected" {
 		conn.Close()
+		c.state.set(TransientFailure)
 		return fmt.Errorf("unexpected status: %v", connMsg["status"])
 	}
 
 	sessionID, _ := connMsg["session_id"].(string)
+	c.mu.Lock()
+	resumeToken := c.resumeToken
+	priorSessionID := c.sessionID
+	if rt, ok := connMsg["resume_token"].(string); ok && rt != "" {
+		c.resumeToken = rt
+	}
+	c.mu.Unlock()
 
 	// Send register
 	regMsg := map[string]interface{}{
@@ -185,11 +288,23 @@ func (c *Client) Connect(ctx context.Context) error {
 		"client_id":  c.clientID,
 		"appid":      c.appID,
 	}
-	if len(c.subscribeNumbers) > 0 {
-		regMsg["subscribe_numbers"] = c.subscribeNumbers
+	if numbers := c.getSubscribeNumbers(); len(numbers) > 0 {
+		regMsg["subscribe_numbers"] = numbers
+	}
+	// Echo back the resume token and prior session from a previous
+	// connected message, if any, plus the last event sequence number we
+	// observed, so the server can replay missed events and resume this
+	// exact session rather than starting a brand new one on reconnect.
+	if resumeToken != "" {
+		regMsg["resume_token"] = resumeToken
+		regMsg["resume"] = map[string]interface{}{
+			"session_id": priorSessionID,
+			"last_seq":   c.lastSeq.Load(),
+		}
 	}
 	if err := conn.WriteJSON(regMsg); err != nil {
 		conn.Close()
+		c.state.set(TransientFailure)
 		return fmt.Errorf("register send failed: %w", err)
 	}
 
@@ -197,26 +312,43 @@ func (c *Client) Connect(ctx context.Context) error {
 	_, msg, err = conn.ReadMessage()
 	if err != nil {
 		conn.Close()
+		c.state.set(TransientFailure)
 		return fmt.Errorf("failed to read register response: %w", err)
 	}
 
 	var regResp map[string]interface{}
 	if err := json.Unmarshal(msg, &regResp); err != nil {
 		conn.Close()
+		c.state.set(TransientFailure)
 		return fmt.Errorf("invalid register response: %w", err)
 	}
 
 	if regResp["status"] != "registered" {
 		conn.Close()
+		c.state.set(TransientFailure)
 		return fmt.Errorf("registration failed: %v", regResp["error"])
 	}
 
+	resumed, _ := regResp["resumed"].(bool)
+	if rsid, ok := regResp["session_id"].(string); ok && rsid != "" {
+		sessionID = rsid
+	}
+	if !resumed {
+		// The server started a fresh session: our replay position no
+		// longer means anything, and orphaned calls from before the
+		// outage should be treated as lost rather than resumed.
+		c.lastSeq.Store(0)
+	}
+
 	// Store conn under lock
 	c.mu.Lock()
 	c.conn = conn
+	c.sessionID = sessionID
+	c.lastResumed = resumed
 	c.mu.Unlock()
 
 	c.connected.Store(true)
+	c.state.set(Ready)
 
 	// Set up ping/pong for keepalive
 	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
@@ -245,13 +377,13 @@ func (c *Client) Connect(ctx context.Context) error {
 }
 
 // Subscribe updates the phone number subscriptions on a live connection.
-func (c *Client) Subscribe(ctx context.Context, numbers []string) error {
-	c.subscribeNumbers = numbers
+func (c *Client) Subscribe(ctx context.Context, numbers []string, opts ...CallOption) error {
+	c.SetSubscribeNumbers(numbers)
 	msg := map[string]interface{}{
 		"action":  "subscribe",
 		"numbers": numbers,
 	}
-	resp, err := c.sendCommand(ctx, "subscribe", msg)
+	resp, err := c.invoke(ctx, "subscribe", msg, true, opts...)
 	if err != nil {
 		return err
 	}
@@ -267,6 +399,7 @@ func (c *Client) Subscribe(ctx context.Context, numbers []string) error {
 // Close gracefully closes the WebSocket connection.
 func (c *Client) Close() error {
 	c.connected.Store(false)
+	c.state.set(Shutdown)
 	select {
 	case <-c.done:
 	default:
@@ -296,7 +429,15 @@ func (c *Client) Close() error {
 }
 
 // Dial places an outbound call.
-func (c *Client) Dial(ctx context.Context, params DialParams) (*DialResult, error) {
+func (c *Client) Dial(ctx context.Context, params DialParams, opts ...CallOption) (*DialResult, error) {
+	if params.Timeout == "" || params.AutoTimeout {
+		if deadline, ok := ctx.Deadline(); ok {
+			if remaining := time.Until(deadline); remaining >= time.Second {
+				params.Timeout = strconv.Itoa(int(remaining / time.Second))
+			}
+		}
+	}
+
 	msg := map[string]interface{}{
 		"action":  "outbound",
 		"to":      params.To,
@@ -331,12 +472,16 @@ func (c *Client) Dial(ctx context.Context, params DialParams) (*DialResult, erro
 	}
 	c.mu.Unlock()
 
-	resp, err := c.sendCommand(ctx, "outbound", msg)
+	resp, err := c.invoke(ctx, "outbound", msg, false, c.proxyAwareOpts(opts)...)
 	if err != nil {
 		// Clean up pre-tracked entry on failure
 		c.mu.Lock()
+		call := c.calls[chanKey]
 		delete(c.calls, chanKey)
 		c.mu.Unlock()
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			c.synthesizeCancelHangup(call)
+		}
 		return nil, err
 	}
 	if resp == nil {
@@ -372,7 +517,7 @@ func (c *Client) Dial(ctx context.Context, params DialParams) (*DialResult, erro
 }
 
 // Accept accepts an inbound call with credentials.
-func (c *Client) Accept(ctx context.Context, callid string, creds AcceptParams) error {
+func (c *Client) Accept(ctx context.Context, callid string, creds AcceptParams, opts ...CallOption) error {
 	msg := map[string]interface{}{
 		"action":  "accept",
 		"callid":  callid,
@@ -393,8 +538,15 @@ func (c *Client) Accept(ctx context.Context, callid string, creds AcceptParams)
 		msg["audio_scenario"] = creds.AudioScenario
 	}
 
-	resp, err := c.sendCommand(ctx, "accept", msg)
+	resp, err := c.invoke(ctx, "accept", msg, false, c.proxyAwareOpts(opts)...)
 	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			c.mu.Lock()
+			call := c.calls[callid]
+			delete(c.calls, callid)
+			c.mu.Unlock()
+			c.synthesizeCancelHangup(call)
+		}
 		return err
 	}
 	if resp == nil {
@@ -415,6 +567,28 @@ func (c *Client) Accept(ctx context.Context, callid string, creds AcceptParams)
 	return nil
 }
 
+// synthesizeCancelHangup delivers OnCallHangup with HangupReason set to
+// ReasonClientCancelled for a Dial/Accept abandoned because ctx expired or
+// was cancelled. If the command was already in flight, sendCommandDirect
+// wrote a cancel frame so the gateway stops ringing; if ctx ended before
+// the command was sent, no frame was written. The gateway's own
+// call_hangup event (if any) may arrive
+// late or never — the caller gets a definitive answer now rather than
+// waiting on it. call is nil-safe since the pre-tracked entry it reads
+// under lock may already be gone (e.g. connection lost concurrently).
+func (c *Client) synthesizeCancelHangup(call *Call) {
+	if call == nil {
+		return
+	}
+	h := c.getHandler()
+	if h == nil {
+		return
+	}
+	call.State = "hangup"
+	call.HangupReason = ReasonClientCancelled
+	h.OnCallHangup(call)
+}
+
 // Reject rejects an inbound call.
 func (c *Client) Reject(ctx context.Context, callid, reason string) error {
 	msg := map[string]interface{}{
@@ -437,7 +611,7 @@ func (c *Client) Reject(ctx context.Context, callid, reason string) error {
 }
 
 // Bridge bridges the call to an Agora channel.
-func (c *Client) Bridge(ctx context.Context, callid string, creds BridgeParams) error {
+func (c *Client) Bridge(ctx context.Context, callid string, creds BridgeParams, opts ...CallOption) error {
 	msg := map[string]interface{}{
 		"action":  "bridge",
 		"callid":  callid,
@@ -455,7 +629,7 @@ func (c *Client) Bridge(ctx context.Context, callid string, creds BridgeParams)
 		msg["audio_scenario"] = creds.AudioScenario
 	}
 
-	resp, err := c.sendCommand(ctx, "bridge", msg)
+	resp, err := c.invoke(ctx, "bridge", msg, false, opts...)
 	if err != nil {
 		return err
 	}
@@ -469,7 +643,7 @@ func (c *Client) Bridge(ctx context.Context, callid string, creds BridgeParams)
 }
 
 // Unbridge removes the Agora channel bridge from the call.
-func (c *Client) Unbridge(ctx context.Context, callid string) error {
+func (c *Client) Unbridge(ctx context.Context, callid string, opts ...CallOption) error {
 	msg := map[string]interface{}{
 		"action": "unbridge",
 		"callid": callid,
@@ -479,7 +653,7 @@ func (c *Client) Unbridge(ctx context.Context, callid string) error {
 		msg["appid"] = call.AppID
 	}
 	c.mu.RUnlock()
-	resp, err := c.sendCommand(ctx, "unbridge", msg)
+	resp, err := c.invoke(ctx, "unbridge", msg, true, opts...)
 	if err != nil {
 		return err
 	}
@@ -493,7 +667,7 @@ func (c *Client) Unbridge(ctx context.Context, callid string) error {
 }
 
 // Hangup ends a call. Sends endcall for outbound, hangup for inbound.
-func (c *Client) Hangup(ctx context.Context, callid string) error {
+func (c *Client) Hangup(ctx context.Context, callid string, opts ...CallOption) error {
 	c.mu.RLock()
 	call := c.calls[callid]
 	c.mu.RUnlock()
@@ -511,7 +685,7 @@ func (c *Client) Hangup(ctx context.Context, callid string) error {
 		msg["appid"] = call.AppID
 	}
 
-	resp, err := c.sendCommand(ctx, action, msg)
+	resp, err := c.invoke(ctx, action, msg, false, opts...)
 	if err != nil {
 		return err
 	}
@@ -530,7 +704,7 @@ func (c *Client) Hangup(ctx context.Context, callid string) error {
 }
 
 // Transfer transfers a call to another destination.
-func (c *Client) Transfer(ctx context.Context, callid, destination, leg string) error {
+func (c *Client) Transfer(ctx context.Context, callid, destination, leg string, opts ...CallOption) error {
 	msg := map[string]interface{}{
 		"action":      "transfer",
 		"callid":      callid,
@@ -545,7 +719,7 @@ func (c *Client) Transfer(ctx context.Context, callid, destination, leg string)
 	}
 	c.mu.RUnlock()
 
-	resp, err := c.sendCommand(ctx, "transfer", msg)
+	resp, err := c.invoke(ctx, "transfer", msg, false, opts...)
 	if err != nil {
 		return err
 	}
@@ -559,7 +733,7 @@ func (c *Client) Transfer(ctx context.Context, callid, destination, leg string)
 }
 
 // SendDTMF sends DTMF digits on an active call.
-func (c *Client) SendDTMF(ctx context.Context, callid, digits string) error {
+func (c *Client) SendDTMF(ctx context.Context, callid, digits string, opts ...CallOption) error {
 	msg := map[string]interface{}{
 		"action": "send_dtmf",
 		"callid": callid,
@@ -570,7 +744,7 @@ func (c *Client) SendDTMF(ctx context.Context, callid, digits string) error {
 		msg["appid"] = call.AppID
 	}
 	c.mu.RUnlock()
-	resp, err := c.sendCommand(ctx, "send_dtmf", msg)
+	resp, err := c.invoke(ctx, "send_dtmf", msg, false, opts...)
 	if err != nil {
 		return err
 	}
@@ -600,6 +774,39 @@ func (c *Client) IsConnected() bool {
 	return c.connected.Load()
 }
 
+// State returns the client's current ConnectivityState.
+func (c *Client) State() ConnectivityState {
+	return c.state.get()
+}
+
+// WaitForStateChange blocks until the ConnectivityState differs from
+// current, ctx is done, or the client shuts down, then returns the state
+// observed at wake time. Use this to block a Dial until the connection
+// reaches a stable Ready state:
+//
+//	for client.State() != telephony.Ready {
+//		if _, err := client.WaitForStateChange(ctx, client.State()); err != nil {
+//			return err
+//		}
+//	}
+func (c *Client) WaitForStateChange(ctx context.Context, current ConnectivityState) (ConnectivityState, error) {
+	return c.state.waitForChange(ctx, current)
+}
+
+// Notify registers ch to receive every ConnectivityState transition for the
+// life of the client. Sends are non-blocking — give ch enough buffer to
+// keep up, or it will miss intermediate transitions. Consumers can use this
+// to drive their own supervisors off the reconnect loop instead of polling
+// IsConnected.
+func (c *Client) Notify(ch chan<- ConnectivityState) {
+	c.state.notify(ch)
+}
+
+// StopNotify unregisters a watcher previously passed to Notify.
+func (c *Client) StopNotify(ch chan<- ConnectivityState) {
+	c.state.stopNotify(ch)
+}
+
 // --- Internal methods ---
 
 func (c *Client) pingLoop(conn *websocket.Conn) {
@@ -614,6 +821,7 @@ func (c *Client) pingLoop(conn *websocket.Conn) {
 			err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(5*time.Second))
 			c.writeMu.Unlock()
 			if err != nil {
+				c.getLogger().Warnf("ping failed: %v (%s)", err, fields("client_id", c.clientID))
 				return
 			}
 		}
@@ -646,6 +854,8 @@ func (c *Client) readLoop(conn *websocket.Conn) {
 		_, msg, err := conn.ReadMessage()
 		if err != nil {
 			if c.connected.Load() {
+				c.state.set(TransientFailure)
+				c.getLogger().Warnf("websocket read error: %v (%s)", err, fields("client_id", c.clientID))
 				h := c.getHandler()
 				if h != nil {
 					h.OnError(fmt.Errorf("read error: %w", err))
@@ -660,6 +870,8 @@ func (c *Client) readLoop(conn *websocket.Conn) {
 			continue
 		}
 
+		c.recordSeq(parsed)
+
 		// Match response to pending command by request_id
 		if reqID, ok := parsed["request_id"].(string); ok && reqID != "" {
 			c.pendingMu.Lock()
@@ -680,17 +892,59 @@ func (c *Client) readLoop(conn *websocket.Conn) {
 	}
 }
 
-func (c *Client) sendCommand(ctx context.Context, action string, msg map[string]interface{}) (map[string]interface{}, error) {
+func (c *Client) sendCommand(ctx context.Context, action string, msg map[string]interface{}, opts ...CallOption) (map[string]interface{}, error) {
+	o := applyCallOptions(opts)
+
+	if state := c.state.get(); state != Ready {
+		if !o.waitForReady {
+			return nil, errors.New("not connected")
+		}
+		for state != Ready {
+			var err error
+			state, err = c.state.waitForChange(ctx, state)
+			if err != nil {
+				return nil, err
+			}
+			if state == Shutdown {
+				return nil, errors.New("not connected")
+			}
+		}
+	}
+
 	if !c.connected.Load() {
 		return nil, errors.New("not connected")
 	}
+
+	invoker := chainCommandInterceptors(c.getCommandInterceptors(), func(ctx context.Context, action string, msg map[string]interface{}) (map[string]interface{}, error) {
+		return c.sendCommandDirect(ctx, action, msg, o.idempotencyKey, o.timeout)
+	})
+	return invoker(ctx, action, msg)
+}
+
+// defaultCommandTimeout is the round-trip deadline for a command awaiting
+// its response. proxyCommandTimeout is used instead for Dial/Accept while a
+// Transport/proxy is in effect, trading the single retry attempt they fall
+// back to for more room on that one attempt.
+const (
+	defaultCommandTimeout = 30 * time.Second
+	proxyCommandTimeout   = 90 * time.Second
+)
+
+// sendCommandDirect performs the actual request/response round trip over
+// the websocket — the innermost Invoker in the command interceptor chain.
+func (c *Client) sendCommandDirect(ctx context.Context, action string, msg map[string]interface{}, idempotencyKey string, timeout time.Duration) (map[string]interface{}, error) {
 	conn := c.getConn()
 	if conn == nil {
 		return nil, errors.New("not connected")
 	}
 
-	// Generate unique request_id for response matching
-	reqID := fmt.Sprintf("%s_%d", action, c.nextReqID.Add(1))
+	// Reuse the caller's idempotency key as request_id (so a retried attempt
+	// lets the server deduplicate) or generate a fresh one for response
+	// matching.
+	reqID := idempotencyKey
+	if reqID == "" {
+		reqID = fmt.Sprintf("%s_%d", action, c.nextReqID.Add(1))
+	}
 	msg["request_id"] = reqID
 
 	respCh := make(chan map[string]interface{}, 1)
@@ -708,7 +962,10 @@ func (c *Client) sendCommand(ctx context.Context, action string, msg map[string]
 		return nil, fmt.Errorf("send failed: %w", err)
 	}
 
-	deadline := time.NewTimer(30 * time.Second)
+	if timeout <= 0 {
+		timeout = defaultCommandTimeout
+	}
+	deadline := time.NewTimer(timeout)
 	defer deadline.Stop()
 
 	select {
@@ -721,26 +978,65 @@ func (c *Client) sendCommand(ctx context.Context, action string, msg map[string]
 		c.pendingMu.Lock()
 		delete(c.pending, reqID)
 		c.pendingMu.Unlock()
+		if action == "outbound" || action == "accept" {
+			c.sendCancel(reqID, msg)
+		}
 		return nil, ctx.Err()
 	case <-deadline.C:
 		c.pendingMu.Lock()
 		delete(c.pending, reqID)
 		c.pendingMu.Unlock()
+		c.getLogger().Warnf("command timeout: %s", fields("action", action, "request_id", reqID))
 		return nil, errors.New("command timeout")
 	}
 }
 
-func (c *Client) handleEvent(msg map[string]interface{}) {
-	h := c.getHandler()
-	if h == nil {
+// sendCancel tells the gateway to stop an in-flight Dial/Accept identified
+// by reqID — e.g. the caller's context expired before the gateway's own
+// ring timeout would have — so it stops ringing immediately instead of
+// running out its own timer. Best effort: there's no response to wait for,
+// and a write failure (connection already gone) is expected and not logged.
+func (c *Client) sendCancel(reqID string, originalMsg map[string]interface{}) {
+	conn := c.getConn()
+	if conn == nil {
 		return
 	}
+	cancelMsg := map[string]interface{}{
+		"action":     "cancel",
+		"request_id": reqID,
+	}
+	if callid, ok := originalMsg["callid"].(string); ok && callid != "" {
+		cancelMsg["callid"] = callid
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = conn.WriteJSON(cancelMsg)
+}
+
+// handleEvent runs msg through the event interceptor chain before
+// dispatching it to the EventHandler.
+func (c *Client) handleEvent(msg map[string]interface{}) {
+	chain := chainEventInterceptors(c.getEventInterceptors(), c.dispatchEvent)
+	chain(msg)
+}
 
+// dispatchEvent is the innermost EventDispatcher in the event interceptor
+// chain: it updates call state and invokes the registered EventHandler.
+func (c *Client) dispatchEvent(msg map[string]interface{}) {
 	eventType, _ := msg["event"].(string)
 	callid, _ := msg["callid"].(string)
 	channel, _ := msg["channel"].(string)
 	uid, _ := msg["uid"].(string)
 
+	// Publish to Client.Events() regardless of whether an EventHandler is
+	// registered — the two consumption styles are independent.
+	c.publishEvent(eventType, callid, channel, msg)
+
+	h := c.getHandler()
+	if h == nil {
+		return
+	}
+
 	// Find or create call state, update fields — all under lock
 	c.mu.Lock()
 	call := c.calls[callid]
@@ -833,30 +1129,50 @@ func (c *Client) handleEvent(msg map[string]interface{}) {
 		if dh, ok := h.(DTMFHandler); ok {
 			dh.OnDTMFReceived(call, digits)
 		}
+	default:
+		c.getLogger().Warnf("unexpected event type: %q (%s)", eventType, fields("callid", callid))
 	}
 }
 
 func (c *Client) reconnect() {
-	backoff := time.Second
-	maxBackoff := 30 * time.Second
+	const (
+		initialBackoff = 500 * time.Millisecond
+		maxBackoff     = 30 * time.Second
+	)
+	backoff := initialBackoff
 
-	for {
+	for attempt := 1; c.maxReconnectAttempts <= 0 || attempt <= c.maxReconnectAttempts; attempt++ {
 		select {
 		case <-c.done:
 			return
 		default:
 		}
 
-		time.Sleep(backoff)
+		time.Sleep(jitter(backoff))
+
+		c.getLogger().Infof("reconnect attempt: %s", fields("client_id", c.clientID, "attempt", attempt, "backoff", backoff))
 
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		err := c.Connect(ctx)
+		err := c.Connect(ctx) // Connect itself drives Connecting -> Ready/TransientFailure
 		cancel()
 
 		if err == nil {
+			resyncCtx, resyncCancel := context.WithTimeout(context.Background(), 10*time.Second)
+			if err := c.resync(resyncCtx); err != nil {
+				c.getLogger().Warnf("resync failed: %v (%s)", err, fields("client_id", c.clientID))
+			}
+			resyncCancel()
+
+			sessionID, resumed := c.sessionInfo()
+			if h := c.getHandler(); h != nil {
+				if rh, ok := h.(ReconnectHandler); ok {
+					rh.OnReconnect(sessionID, resumed)
+				}
+			}
 			return
 		}
 
+		c.getLogger().Errorf("reconnect failed: %v (%s)", err, fields("client_id", c.clientID))
 		h := c.getHandler()
 		if h != nil {
 			h.OnError(fmt.Errorf("reconnect failed: %w", err))
@@ -867,4 +1183,6 @@ func (c *Client) reconnect() {
 			backoff = maxBackoff
 		}
 	}
+
+	c.getLogger().Errorf("giving up reconnecting: %s", fields("client_id", c.clientID, "max_attempts", c.maxReconnectAttempts))
 }
@@ -0,0 +1,22 @@
+package telephony
+
+// ClientOption configures a Client at construction time via NewClient.
+type ClientOption func(*Client)
+
+// WithLogger sets the Logger used for internal diagnostic logging —
+// websocket read errors, reconnect attempts, ping failures, unexpected
+// event types, command timeouts. Defaults to NopLogger.
+func WithLogger(l Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = l
+	}
+}
+
+// WithMaxReconnectAttempts caps the number of automatic reconnect attempts
+// made after the connection drops before the client gives up. n <= 0 (the
+// default) means retry forever.
+func WithMaxReconnectAttempts(n int) ClientOption {
+	return func(c *Client) {
+		c.maxReconnectAttempts = n
+	}
+}
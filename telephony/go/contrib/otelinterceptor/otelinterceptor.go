@@ -0,0 +1,40 @@
+// Package otelinterceptor provides a telephony.CommandInterceptor that
+// starts an OpenTelemetry span for every command, keyed on request_id.
+package otelinterceptor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	telephony "github.com/AgoraIO/telephony-go"
+)
+
+// New returns a CommandInterceptor that wraps each command in a span named
+// "telephony.<action>" under tracerName, tagged with the action and
+// request_id and marked as errored when the command fails.
+func New(tracerName string) telephony.CommandInterceptor {
+	tracer := otel.Tracer(tracerName)
+
+	return func(ctx context.Context, action string, msg map[string]interface{}, next telephony.Invoker) (map[string]interface{}, error) {
+		ctx, span := tracer.Start(ctx, "telephony."+action, trace.WithAttributes(
+			attribute.String("telephony.action", action),
+		))
+		defer span.End()
+
+		resp, err := next(ctx, action, msg)
+
+		if reqID, ok := msg["request_id"].(string); ok {
+			span.SetAttributes(attribute.String("telephony.request_id", reqID))
+		}
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+		}
+
+		return resp, err
+	}
+}
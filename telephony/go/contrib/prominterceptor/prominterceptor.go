@@ -0,0 +1,69 @@
+// Package prominterceptor provides Prometheus metrics for telephony
+// commands and events: per-action latency histograms and per-event-type
+// counters.
+package prominterceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	telephony "github.com/AgoraIO/telephony-go"
+)
+
+// Interceptor holds the registered Prometheus collectors and exposes a
+// CommandInterceptor and an EventInterceptor built on top of them.
+type Interceptor struct {
+	commandLatency *prometheus.HistogramVec
+	commandErrors  *prometheus.CounterVec
+	events         *prometheus.CounterVec
+}
+
+// New registers the interceptor's collectors with reg and returns the
+// Interceptor.
+func New(reg prometheus.Registerer) *Interceptor {
+	i := &Interceptor{
+		commandLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "telephony",
+			Name:      "command_latency_seconds",
+			Help:      "Latency of telephony SDK commands by action.",
+		}, []string{"action"}),
+		commandErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "telephony",
+			Name:      "command_errors_total",
+			Help:      "Count of telephony SDK command failures by action.",
+		}, []string{"action"}),
+		events: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "telephony",
+			Name:      "events_total",
+			Help:      "Count of telephony SDK inbound events by event type.",
+		}, []string{"event"}),
+	}
+	reg.MustRegister(i.commandLatency, i.commandErrors, i.events)
+	return i
+}
+
+// Command returns a CommandInterceptor that observes per-action latency and
+// counts failures.
+func (i *Interceptor) Command() telephony.CommandInterceptor {
+	return func(ctx context.Context, action string, msg map[string]interface{}, next telephony.Invoker) (map[string]interface{}, error) {
+		start := time.Now()
+		resp, err := next(ctx, action, msg)
+		i.commandLatency.WithLabelValues(action).Observe(time.Since(start).Seconds())
+		if err != nil {
+			i.commandErrors.WithLabelValues(action).Inc()
+		}
+		return resp, err
+	}
+}
+
+// Event returns an EventInterceptor that counts inbound events by type.
+func (i *Interceptor) Event() telephony.EventInterceptor {
+	return func(msg map[string]interface{}, next telephony.EventDispatcher) {
+		if eventType, ok := msg["event"].(string); ok {
+			i.events.WithLabelValues(eventType).Inc()
+		}
+		next(msg)
+	}
+}
@@ -0,0 +1,48 @@
+// Package redaction provides a telephony.CommandInterceptor that logs each
+// command with sensitive fields scrubbed, so audit logging can be wired in
+// without ever writing a raw token or auth header to a log sink.
+package redaction
+
+import (
+	"context"
+
+	telephony "github.com/AgoraIO/telephony-go"
+)
+
+// sensitiveKeys are msg fields replaced with "***" before logging. The
+// values are still sent to the server untouched — only the logged copy is
+// redacted.
+var sensitiveKeys = []string{"token", "auth_token", "sdk_options"}
+
+// New returns a CommandInterceptor that logs every command (at Debug) and
+// its outcome (at Debug, or Warn on error) through logger, with
+// sensitiveKeys masked in the logged payload.
+func New(logger telephony.Logger) telephony.CommandInterceptor {
+	return func(ctx context.Context, action string, msg map[string]interface{}, next telephony.Invoker) (map[string]interface{}, error) {
+		logger.Debugf("command %s: %v", action, redacted(msg))
+
+		resp, err := next(ctx, action, msg)
+		if err != nil {
+			logger.Warnf("command %s failed: %v", action, err)
+		} else {
+			logger.Debugf("command %s ok: %v", action, redacted(resp))
+		}
+		return resp, err
+	}
+}
+
+func redacted(msg map[string]interface{}) map[string]interface{} {
+	if msg == nil {
+		return nil
+	}
+	out := make(map[string]interface{}, len(msg))
+	for k, v := range msg {
+		out[k] = v
+	}
+	for _, k := range sensitiveKeys {
+		if _, ok := out[k]; ok {
+			out[k] = "***"
+		}
+	}
+	return out
+}
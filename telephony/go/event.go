@@ -0,0 +1,83 @@
+package telephony
+
+import "time"
+
+// Event is a typed, flattened view of a single call-lifecycle occurrence —
+// the same occurrences delivered to EventHandler's callbacks, shaped for
+// streaming consumption via Client.Events() or an EventSink instead of one
+// method per event kind. Kind mirrors the gateway's own "event" field
+// (e.g. "call_ringing", "agora_bridge_start", "dtmf_received"). On events
+// from Client.Events(), Extra carries the full decoded message so a
+// consumer can recover fields this struct doesn't promote. Events built by
+// SinkAdapter instead carry only the callback's own extra value in Extra
+// (digits, session_id or error; nil for the other call events), and its
+// OnConnected and OnError use the Kinds "connected" and "error".
+type Event struct {
+	Kind      string                 `json:"event"`
+	CallID    string                 `json:"callid,omitempty"`
+	Channel   string                 `json:"channel,omitempty"`
+	From      string                 `json:"from,omitempty"`
+	To        string                 `json:"to,omitempty"`
+	Timestamp time.Time              `json:"timestamp"`
+	Extra     map[string]interface{} `json:"extra,omitempty"`
+}
+
+// EventSink receives a copy of every Event a Client dispatches. Register
+// one without writing a full EventHandler by wrapping it in a SinkAdapter
+// and passing that to SetHandler.
+type EventSink interface {
+	HandleEvent(Event)
+}
+
+// eventsChanBuffer sizes the channel Events() creates. Sends to it are
+// non-blocking, same as Notify's ConnectivityState watchers, so a consumer
+// that falls behind by more than this many events misses some rather than
+// stalling the read loop.
+const eventsChanBuffer = 64
+
+// Events returns a channel streaming every dispatched call-lifecycle event
+// as a typed Event — an alternative to implementing EventHandler for
+// consumers that would rather range over a channel than handle callbacks.
+// The channel is created on first call and reused by later calls; it is
+// never closed, so a consumer should stop reading once it knows the Client
+// is done (e.g. after IsConnected goes false for good).
+func (c *Client) Events() <-chan Event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.eventsCh == nil {
+		c.eventsCh = make(chan Event, eventsChanBuffer)
+	}
+	return c.eventsCh
+}
+
+// getEventsCh returns the channel Events() hands out, or nil if nobody has
+// called Events() yet.
+func (c *Client) getEventsCh() chan Event {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.eventsCh
+}
+
+// publishEvent delivers raw, the just-decoded event message, to
+// Client.Events() as a typed Event, if anyone has called Events(). A no-op
+// otherwise, so clients that only use EventHandler pay nothing for this.
+func (c *Client) publishEvent(kind, callid, channel string, raw map[string]interface{}) {
+	ch := c.getEventsCh()
+	if ch == nil {
+		return
+	}
+	from, _ := raw["from"].(string)
+	to, _ := raw["to"].(string)
+	select {
+	case ch <- Event{
+		Kind:      kind,
+		CallID:    callid,
+		Channel:   channel,
+		From:      from,
+		To:        to,
+		Timestamp: time.Now().UTC(),
+		Extra:     raw,
+	}:
+	default:
+	}
+}
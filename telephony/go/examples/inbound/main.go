@@ -18,7 +18,6 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -27,22 +26,18 @@ import (
 	"time"
 
 	telephony "github.com/AgoraIO/telephony-go"
+	"github.com/AgoraIO/telephony-go/sinks"
 )
 
 type handler struct {
+	*telephony.SinkAdapter
 	client *telephony.Client
 	appID  string
 	done   chan struct{}
 }
 
-func (h *handler) OnConnected(sessionID string) {
-	logEvent("connected", map[string]string{"session_id": sessionID})
-}
-
 func (h *handler) OnCallIncoming(call *telephony.Call) bool {
-	logEvent("call_incoming", map[string]string{
-		"callid": call.CallID, "from": call.From, "to": call.To,
-	})
+	h.SinkAdapter.OnCallIncoming(call)
 
 	// Accept the call asynchronously
 	go func() {
@@ -66,24 +61,8 @@ func (h *handler) OnCallIncoming(call *telephony.Call) bool {
 	return true // claim the call
 }
 
-func (h *handler) OnCallRinging(call *telephony.Call) {
-	logEvent("call_ringing", map[string]string{"callid": call.CallID})
-}
-
-func (h *handler) OnCallAnswered(call *telephony.Call) {
-	logEvent("call_answered", map[string]string{"callid": call.CallID})
-}
-
-func (h *handler) OnBridgeStart(call *telephony.Call) {
-	logEvent("agora_bridge_start", map[string]string{"callid": call.CallID, "channel": call.Channel})
-}
-
-func (h *handler) OnBridgeEnd(call *telephony.Call) {
-	logEvent("agora_bridge_end", map[string]string{"callid": call.CallID})
-}
-
 func (h *handler) OnCallHangup(call *telephony.Call) {
-	logEvent("call_hangup", map[string]string{"callid": call.CallID})
+	h.SinkAdapter.OnCallHangup(call)
 	select {
 	case h.done <- struct{}{}:
 	default:
@@ -94,10 +73,6 @@ func (h *handler) OnError(err error) {
 	log.Printf("Error: %v", err)
 }
 
-func (h *handler) OnDTMFReceived(call *telephony.Call, digits string) {
-	logEvent("dtmf_received", map[string]string{"callid": call.CallID, "digits": digits})
-}
-
 func main() {
 	cmHost := envOrDefault("CM_HOST", "wss://sipcm.agora.io")
 	authToken := requireEnv("AUTH_TOKEN")
@@ -108,7 +83,12 @@ func main() {
 	clientID := fmt.Sprintf("inbound-example-%d", time.Now().UnixMilli())
 
 	client := telephony.NewClient(wsURL, authToken, clientID, appID)
-	h := &handler{client: client, appID: appID, done: make(chan struct{}, 1)}
+	h := &handler{
+		SinkAdapter: telephony.NewSinkAdapter(sinks.NewConsoleSink()),
+		client:      client,
+		appID:       appID,
+		done:        make(chan struct{}, 1),
+	}
 	client.SetHandler(h)
 
 	// Subscribe to the DID before connecting
@@ -140,13 +120,6 @@ func main() {
 	fmt.Println("Done")
 }
 
-func logEvent(event string, data map[string]string) {
-	data["event"] = event
-	data["timestamp"] = time.Now().UTC().Format(time.RFC3339)
-	b, _ := json.Marshal(data)
-	fmt.Println(string(b))
-}
-
 func requireEnv(key string) string {
 	v := os.Getenv(key)
 	if v == "" {
@@ -16,57 +16,32 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"time"
 
 	telephony "github.com/AgoraIO/telephony-go"
+	"github.com/AgoraIO/telephony-go/sinks"
 )
 
 type handler struct {
+	*telephony.SinkAdapter
 	bridged chan struct{}
 }
 
-func (h *handler) OnConnected(sessionID string) {
-	logEvent("connected", map[string]string{"session_id": sessionID})
-}
-
-func (h *handler) OnCallIncoming(call *telephony.Call) bool { return false }
-
-func (h *handler) OnCallRinging(call *telephony.Call) {
-	logEvent("call_ringing", map[string]string{"callid": call.CallID})
-}
-
-func (h *handler) OnCallAnswered(call *telephony.Call) {
-	logEvent("call_answered", map[string]string{"callid": call.CallID})
-}
-
 func (h *handler) OnBridgeStart(call *telephony.Call) {
-	logEvent("agora_bridge_start", map[string]string{"callid": call.CallID, "channel": call.Channel})
+	h.SinkAdapter.OnBridgeStart(call)
 	select {
 	case h.bridged <- struct{}{}:
 	default:
 	}
 }
 
-func (h *handler) OnBridgeEnd(call *telephony.Call) {
-	logEvent("agora_bridge_end", map[string]string{"callid": call.CallID})
-}
-
-func (h *handler) OnCallHangup(call *telephony.Call) {
-	logEvent("call_hangup", map[string]string{"callid": call.CallID})
-}
-
 func (h *handler) OnError(err error) {
 	log.Printf("Error: %v", err)
 }
 
-func (h *handler) OnDTMFReceived(call *telephony.Call, digits string) {
-	logEvent("dtmf_received", map[string]string{"callid": call.CallID, "digits": digits})
-}
-
 func main() {
 	cmHost := envOrDefault("CM_HOST", "wss://your-cm-host")
 	authToken := requireEnv("AUTH_TOKEN")
@@ -81,7 +56,10 @@ func main() {
 	channel := fmt.Sprintf("example_%d", time.Now().UnixMilli())
 
 	client := telephony.NewClient(wsURL, authToken, clientID, appID)
-	h := &handler{bridged: make(chan struct{}, 1)}
+	h := &handler{
+		SinkAdapter: telephony.NewSinkAdapter(sinks.NewConsoleSink()),
+		bridged:     make(chan struct{}, 1),
+	}
 	client.SetHandler(h)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 90*time.Second)
@@ -140,13 +118,6 @@ func main() {
 	fmt.Println("Done")
 }
 
-func logEvent(event string, data map[string]string) {
-	data["event"] = event
-	data["timestamp"] = time.Now().UTC().Format(time.RFC3339)
-	b, _ := json.Marshal(data)
-	fmt.Println(string(b))
-}
-
 func requireEnv(key string) string {
 	v := os.Getenv(key)
 	if v == "" {
@@ -0,0 +1,77 @@
+package telephony
+
+import (
+	"context"
+)
+
+// Invoker performs a single command round trip. It is what a
+// CommandInterceptor wraps and ultimately calls via its next parameter.
+type Invoker func(ctx context.Context, action string, msg map[string]interface{}) (map[string]interface{}, error)
+
+// CommandInterceptor wraps a command invocation, gRPC-middleware style.
+// Implementations call next to continue the chain (optionally after
+// mutating msg, e.g. refreshing an auth token) and may inspect or wrap the
+// result and error on the way back out (e.g. to record latency).
+type CommandInterceptor func(ctx context.Context, action string, msg map[string]interface{}, next Invoker) (map[string]interface{}, error)
+
+// EventDispatcher delivers a single decoded event message to the
+// registered EventHandler. It is what an EventInterceptor wraps.
+type EventDispatcher func(msg map[string]interface{})
+
+// EventInterceptor wraps event dispatch. Implementations call next to
+// continue the chain (e.g. after redacting sensitive fields or recording a
+// metric) or drop the event entirely by not calling next.
+type EventInterceptor func(msg map[string]interface{}, next EventDispatcher)
+
+// Use registers one or more CommandInterceptors, run in order (the first
+// registered is outermost) around every Dial/Accept/Bridge/.../Subscribe
+// call. Safe to call at any time; interceptors already mid-chain for an
+// in-flight call are unaffected.
+func (c *Client) Use(interceptors ...CommandInterceptor) {
+	c.interceptorMu.Lock()
+	defer c.interceptorMu.Unlock()
+	c.commandInterceptors = append(c.commandInterceptors, interceptors...)
+}
+
+// UseEvent registers one or more EventInterceptors, run in order (the
+// first registered is outermost) around every inbound event before it
+// reaches the EventHandler.
+func (c *Client) UseEvent(interceptors ...EventInterceptor) {
+	c.interceptorMu.Lock()
+	defer c.interceptorMu.Unlock()
+	c.eventInterceptors = append(c.eventInterceptors, interceptors...)
+}
+
+func (c *Client) getCommandInterceptors() []CommandInterceptor {
+	c.interceptorMu.RLock()
+	defer c.interceptorMu.RUnlock()
+	return c.commandInterceptors
+}
+
+func (c *Client) getEventInterceptors() []EventInterceptor {
+	c.interceptorMu.RLock()
+	defer c.interceptorMu.RUnlock()
+	return c.eventInterceptors
+}
+
+// chainCommandInterceptors composes interceptors around final, outermost
+// first, so interceptors[0] sees the call before interceptors[1], etc.
+func chainCommandInterceptors(interceptors []CommandInterceptor, final Invoker) Invoker {
+	if len(interceptors) == 0 {
+		return final
+	}
+	return func(ctx context.Context, action string, msg map[string]interface{}) (map[string]interface{}, error) {
+		return interceptors[0](ctx, action, msg, chainCommandInterceptors(interceptors[1:], final))
+	}
+}
+
+// chainEventInterceptors composes interceptors around final, outermost
+// first.
+func chainEventInterceptors(interceptors []EventInterceptor, final EventDispatcher) EventDispatcher {
+	if len(interceptors) == 0 {
+		return final
+	}
+	return func(msg map[string]interface{}) {
+		interceptors[0](msg, chainEventInterceptors(interceptors[1:], final))
+	}
+}
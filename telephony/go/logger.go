@@ -0,0 +1,94 @@
+package telephony
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Logger is the interface the client uses for leveled, structured logging.
+// Implement it to route SDK diagnostics — websocket read errors, reconnect
+// attempts, ping failures, unexpected event types, command timeouts — into
+// your own observability stack. Use SetLogger or the WithLogger NewClient
+// option to install one; the default is a no-op logger.
+//
+// Call sites pass structured context (call_id, request_id, action,
+// session_id, ...) through the fields helper, e.g.:
+//
+//	logger.Warnf("command timeout: %s", fields("action", action, "request_id", reqID))
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+	Warnf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+// fields renders alternating key/value pairs as "key=value key2=value2"
+// for inclusion in a Logger format string.
+func fields(kv ...interface{}) string {
+	var b strings.Builder
+	for i := 0; i+1 < len(kv); i += 2 {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%v=%v", kv[i], kv[i+1])
+	}
+	return b.String()
+}
+
+// NopLogger discards everything. It is the Client default.
+type NopLogger struct{}
+
+func (NopLogger) Debugf(format string, args ...interface{}) {}
+func (NopLogger) Infof(format string, args ...interface{})  {}
+func (NopLogger) Warnf(format string, args ...interface{})  {}
+func (NopLogger) Errorf(format string, args ...interface{}) {}
+
+// LogLevel is the minimum severity a ConsoleLogger will emit. FileLogger
+// has no level filter and writes every level.
+type LogLevel int
+
+const (
+	LevelDebug LogLevel = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// ConsoleLogger writes leveled lines (no timestamp) to Out (Debug/Info) or
+// ErrOut (Warn/Error), filtering anything below Level.
+type ConsoleLogger struct {
+	Level  LogLevel
+	Out    io.Writer
+	ErrOut io.Writer
+}
+
+// NewConsoleLogger returns a ConsoleLogger writing Debug/Info to stdout and
+// Warn/Error to stderr, filtered to level and above.
+func NewConsoleLogger(level LogLevel) *ConsoleLogger {
+	return &ConsoleLogger{Level: level, Out: os.Stdout, ErrOut: os.Stderr}
+}
+
+func (l *ConsoleLogger) Debugf(format string, args ...interface{}) {
+	l.logf(LevelDebug, "DEBUG", l.Out, format, args)
+}
+
+func (l *ConsoleLogger) Infof(format string, args ...interface{}) {
+	l.logf(LevelInfo, "INFO", l.Out, format, args)
+}
+
+func (l *ConsoleLogger) Warnf(format string, args ...interface{}) {
+	l.logf(LevelWarn, "WARN", l.ErrOut, format, args)
+}
+
+func (l *ConsoleLogger) Errorf(format string, args ...interface{}) {
+	l.logf(LevelError, "ERROR", l.ErrOut, format, args)
+}
+
+func (l *ConsoleLogger) logf(level LogLevel, tag string, w io.Writer, format string, args []interface{}) {
+	if level < l.Level || w == nil {
+		return
+	}
+	fmt.Fprintf(w, "%s [telephony] %s\n", tag, fmt.Sprintf(format, args...))
+}
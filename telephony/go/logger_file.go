@@ -0,0 +1,140 @@
+package telephony
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileLoggerOptions configures rotation for a FileLogger.
+type FileLoggerOptions struct {
+	// MaxSizeMB rotates the active log file once it exceeds this size.
+	// Zero disables size-based rotation.
+	MaxSizeMB int
+	// MaxBackups is the number of rotated files to keep, oldest deleted
+	// first. Zero keeps them all.
+	MaxBackups int
+	// MaxAge deletes rotated files older than this. Zero keeps them all.
+	MaxAge time.Duration
+}
+
+// FileLogger is a Logger that appends to path, rotating to
+// "path.YYYYMMDDHHMMSS" once the active file exceeds MaxSizeMB, and pruning
+// old backups beyond MaxBackups / MaxAge.
+type FileLogger struct {
+	path string
+	opts FileLoggerOptions
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+// NewFileLogger opens (or creates) path for appending and returns a
+// FileLogger that rotates it per opts.
+func NewFileLogger(path string, opts FileLoggerOptions) (*FileLogger, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open log file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("stat log file: %w", err)
+	}
+	return &FileLogger{path: path, opts: opts, f: f, size: info.Size()}, nil
+}
+
+func (l *FileLogger) Debugf(format string, args ...interface{}) { l.write("DEBUG", format, args) }
+func (l *FileLogger) Infof(format string, args ...interface{})  { l.write("INFO", format, args) }
+func (l *FileLogger) Warnf(format string, args ...interface{})  { l.write("WARN", format, args) }
+func (l *FileLogger) Errorf(format string, args ...interface{}) { l.write("ERROR", format, args) }
+
+func (l *FileLogger) write(tag, format string, args []interface{}) {
+	line := fmt.Sprintf("%s %s [telephony] %s\n", time.Now().UTC().Format(time.RFC3339), tag, fmt.Sprintf(format, args...))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.opts.MaxSizeMB > 0 && l.size+int64(len(line)) > int64(l.opts.MaxSizeMB)*1024*1024 {
+		l.rotateLocked()
+	}
+
+	n, err := l.f.WriteString(line)
+	if err == nil {
+		l.size += int64(n)
+	}
+}
+
+// rotateLocked renames the active file aside and opens a fresh one. Callers
+// must hold l.mu.
+func (l *FileLogger) rotateLocked() {
+	l.f.Close()
+
+	backup := l.path + "." + time.Now().UTC().Format("20060102150405")
+	os.Rename(l.path, backup)
+
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		// Best effort: fall back to appending to the renamed file rather
+		// than losing log output entirely.
+		f, _ = os.OpenFile(backup, os.O_WRONLY|os.O_APPEND, 0o644)
+	}
+	l.f = f
+	l.size = 0
+
+	l.pruneLocked()
+}
+
+// pruneLocked deletes rotated backups beyond MaxBackups / older than
+// MaxAge. Callers must hold l.mu.
+func (l *FileLogger) pruneLocked() {
+	if l.opts.MaxBackups <= 0 && l.opts.MaxAge <= 0 {
+		return
+	}
+
+	dir := filepath.Dir(l.path)
+	prefix := filepath.Base(l.path) + "."
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	var backups []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasPrefix(e.Name(), prefix) {
+			backups = append(backups, filepath.Join(dir, e.Name()))
+		}
+	}
+	sort.Strings(backups) // timestamp suffix sorts chronologically
+
+	if l.opts.MaxAge > 0 {
+		cutoff := time.Now().Add(-l.opts.MaxAge)
+		kept := backups[:0]
+		for _, b := range backups {
+			if info, err := os.Stat(b); err == nil && info.ModTime().Before(cutoff) {
+				os.Remove(b)
+				continue
+			}
+			kept = append(kept, b)
+		}
+		backups = kept
+	}
+
+	if l.opts.MaxBackups > 0 && len(backups) > l.opts.MaxBackups {
+		for _, b := range backups[:len(backups)-l.opts.MaxBackups] {
+			os.Remove(b)
+		}
+	}
+}
+
+// Close flushes and closes the underlying file.
+func (l *FileLogger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.f.Close()
+}
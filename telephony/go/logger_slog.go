@@ -0,0 +1,35 @@
+package telephony
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// SlogLogger adapts a *slog.Logger to the Logger interface.
+type SlogLogger struct {
+	L *slog.Logger
+}
+
+// NewSlogLogger wraps l, or slog.Default() if l is nil.
+func NewSlogLogger(l *slog.Logger) *SlogLogger {
+	if l == nil {
+		l = slog.Default()
+	}
+	return &SlogLogger{L: l}
+}
+
+func (s *SlogLogger) Debugf(format string, args ...interface{}) {
+	s.L.Debug(fmt.Sprintf(format, args...))
+}
+
+func (s *SlogLogger) Infof(format string, args ...interface{}) {
+	s.L.Info(fmt.Sprintf(format, args...))
+}
+
+func (s *SlogLogger) Warnf(format string, args ...interface{}) {
+	s.L.Warn(fmt.Sprintf(format, args...))
+}
+
+func (s *SlogLogger) Errorf(format string, args ...interface{}) {
+	s.L.Error(fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,26 @@
+// Package logrusadapter adapts a *logrus.Logger to telephony.Logger, kept
+// in its own module-free subpackage so the core SDK doesn't force a
+// logrus dependency on users who don't want it.
+package logrusadapter
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// Adapter wraps a *logrus.Logger (or Entry) to satisfy telephony.Logger.
+type Adapter struct {
+	L *logrus.Logger
+}
+
+// New wraps l, or logrus.StandardLogger() if l is nil.
+func New(l *logrus.Logger) *Adapter {
+	if l == nil {
+		l = logrus.StandardLogger()
+	}
+	return &Adapter{L: l}
+}
+
+func (a *Adapter) Debugf(format string, args ...interface{}) { a.L.Debugf(format, args...) }
+func (a *Adapter) Infof(format string, args ...interface{})  { a.L.Infof(format, args...) }
+func (a *Adapter) Warnf(format string, args ...interface{})  { a.L.Warnf(format, args...) }
+func (a *Adapter) Errorf(format string, args ...interface{}) { a.L.Errorf(format, args...) }
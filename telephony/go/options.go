@@ -0,0 +1,61 @@
+package telephony
+
+import "time"
+
+// CallOption configures the behavior of a single command invocation
+// (Dial, Accept, Bridge, Unbridge, Hangup, Transfer, SendDTMF, Subscribe).
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	waitForReady   bool
+	retryPolicy    *RetryPolicy
+	idempotencyKey string
+	timeout        time.Duration
+}
+
+// WithWaitForReady makes the call block until the connection reaches the
+// Ready ConnectivityState (or ctx is done) instead of immediately failing
+// when the client is Connecting or in TransientFailure — e.g. during the
+// exponential-backoff reconnect loop. Off by default, matching gRPC's
+// fail-fast behavior.
+func WithWaitForReady(wait bool) CallOption {
+	return func(o *callOptions) {
+		o.waitForReady = wait
+	}
+}
+
+// WithRetryPolicy overrides the RetryPolicy used for this call. See
+// RetryPolicy for how attempts, backoff, and retryable errors are chosen.
+func WithRetryPolicy(p RetryPolicy) CallOption {
+	return func(o *callOptions) {
+		o.retryPolicy = &p
+	}
+}
+
+// WithIdempotencyKey marks the call as safe to retry even though its action
+// is not naturally idempotent (e.g. Dial). The key is sent as request_id on
+// every attempt so the server can deduplicate a command it already acted
+// on; without a key, a non-idempotent command is attempted only once (no
+// retries) regardless of RetryPolicy.MaxAttempts.
+func WithIdempotencyKey(key string) CallOption {
+	return func(o *callOptions) {
+		o.idempotencyKey = key
+	}
+}
+
+// withCommandTimeout overrides the default 30s command round-trip timeout.
+// Unexported: callers don't pick this directly today, it's how Dial/Accept
+// extend their deadline when a Transport/proxy is in effect.
+func withCommandTimeout(d time.Duration) CallOption {
+	return func(o *callOptions) {
+		o.timeout = d
+	}
+}
+
+func applyCallOptions(opts []CallOption) callOptions {
+	var o callOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
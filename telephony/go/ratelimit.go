@@ -0,0 +1,99 @@
+package telephony
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+)
+
+// establishingActions are call-establishing verbs — these are what a
+// provisioning burst (e.g. spinning up hundreds of outbound calls at
+// startup) hammers, so they get their own, typically stricter, limiter.
+var establishingActions = map[string]bool{
+	"outbound": true,
+	"accept":   true,
+	"bridge":   true,
+	"transfer": true,
+}
+
+// inCallActions are verbs issued against calls already in progress; they
+// fire less often per call, so they share a looser limiter. Hangup sends
+// "endcall" for outbound calls and "hangup" otherwise, so both are listed.
+var inCallActions = map[string]bool{
+	"send_dtmf": true,
+	"unbridge":  true,
+	"hangup":    true,
+	"endcall":   true,
+}
+
+// RateLimiterStats reports a snapshot of one of the client's token-bucket
+// limiters.
+type RateLimiterStats struct {
+	Limit  rate.Limit
+	Burst  int
+	Tokens float64
+}
+
+// WithRateLimit gates call-establishing commands (Dial, Accept, Bridge,
+// Transfer) with a token-bucket limiter of rps (steady-state refill rate)
+// and burst capacity, following the pattern syncthing's
+// lib/connections/service.go uses to throttle outbound dials. Without it,
+// a provisioning burst can get the client kicked off the CM WebSocket for
+// flooding.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.establishLimiter = rate.NewLimiter(rate.Limit(rps), burst)
+	}
+}
+
+// WithInCallRateLimit gates in-call commands (SendDTMF, Unbridge, Hangup)
+// with a separate, typically looser, token-bucket limiter.
+func WithInCallRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.inCallLimiter = rate.NewLimiter(rate.Limit(rps), burst)
+	}
+}
+
+// waitRateLimit blocks until action may proceed under its class's limiter,
+// honoring ctx's deadline rather than queuing forever. Actions with no
+// configured limiter pass through immediately.
+func (c *Client) waitRateLimit(ctx context.Context, action string) error {
+	l := c.limiterFor(action)
+	if l == nil {
+		return nil
+	}
+	if l.Tokens() < 1 {
+		c.getLogger().Debugf("rate limit wait: %s", fields("action", action))
+	}
+	return l.Wait(ctx)
+}
+
+func (c *Client) limiterFor(action string) *rate.Limiter {
+	switch {
+	case establishingActions[action]:
+		return c.establishLimiter
+	case inCallActions[action]:
+		return c.inCallLimiter
+	default:
+		return nil
+	}
+}
+
+// EstablishRateLimiterStats returns the current state of the
+// call-establishing limiter, or nil if WithRateLimit was never set.
+func (c *Client) EstablishRateLimiterStats() *RateLimiterStats {
+	return limiterStats(c.establishLimiter)
+}
+
+// InCallRateLimiterStats returns the current state of the in-call limiter,
+// or nil if WithInCallRateLimit was never set.
+func (c *Client) InCallRateLimiterStats() *RateLimiterStats {
+	return limiterStats(c.inCallLimiter)
+}
+
+func limiterStats(l *rate.Limiter) *RateLimiterStats {
+	if l == nil {
+		return nil
+	}
+	return &RateLimiterStats{Limit: l.Limit(), Burst: l.Burst(), Tokens: l.Tokens()}
+}
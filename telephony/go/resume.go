@@ -0,0 +1,187 @@
+package telephony
+
+import (
+	"context"
+	"errors"
+)
+
+// HangupReason classifies why a Call was delivered to OnCallHangup,
+// letting applications distinguish a gateway-reported hangup from one the
+// client synthesized itself.
+type HangupReason string
+
+const (
+	// ReasonNormal is the zero value: the hangup came directly from the
+	// gateway's call_hangup event.
+	ReasonNormal HangupReason = ""
+	// ReasonServerLost means a post-reconnect resync found the server no
+	// longer tracking a call that was active before the outage.
+	ReasonServerLost HangupReason = "server_lost"
+	// ReasonClientCancelled means the caller's context expired or was
+	// cancelled while a Dial or Accept was still in flight: the client told
+	// the gateway to stop via a cancel frame rather than waiting for its own
+	// ring timeout, and delivered this hangup locally instead of waiting on
+	// (or assuming) a call_hangup event from the far end.
+	ReasonClientCancelled HangupReason = "client_cancelled"
+)
+
+// resync re-synchronizes locally-tracked calls against the server after a
+// reconnect. The server may have hung up calls during the outage, and any
+// command that was in flight when the connection dropped was already
+// answered with nil on the drained pending channel, so c.calls can be
+// stale. resync sends the call IDs the client still has state for and
+// reconciles the server's authoritative per-call status:
+//
+//   - "active"  — refresh the call's fields from the server's view.
+//   - "hung_up" — the server lost the call during the outage; synthesize
+//     OnCallHangup with HangupReason set to ReasonServerLost.
+//   - "unknown" — the server knows about a call the client doesn't;
+//     inject it via OnCallIncoming (already claimed by the far end).
+//
+// Any locally-tracked call the server doesn't mention at all is treated
+// the same as "hung_up".
+func (c *Client) resync(ctx context.Context) error {
+	c.mu.RLock()
+	ids := make([]string, 0, len(c.calls))
+	for id, call := range c.calls {
+		if call.CallID != "" && call.CallID == id {
+			ids = append(ids, id)
+		}
+	}
+	c.mu.RUnlock()
+
+	msg := map[string]interface{}{
+		"action":   "resync",
+		"call_ids": ids,
+	}
+	resp, err := c.sendCommand(ctx, "resync", msg)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return errors.New("connection lost")
+	}
+
+	h := c.getHandler()
+	results, _ := resp["calls"].([]interface{})
+	seen := make(map[string]bool, len(results))
+
+	for _, raw := range results {
+		entry, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		callid, _ := entry["callid"].(string)
+		if callid == "" {
+			continue
+		}
+		seen[callid] = true
+
+		status, _ := entry["status"].(string)
+		switch status {
+		case "active":
+			c.mu.Lock()
+			call := c.calls[callid]
+			if call == nil {
+				call = &Call{CallID: callid}
+				c.calls[callid] = call
+			}
+			applyResyncFields(call, entry)
+			c.mu.Unlock()
+
+		case "hung_up":
+			c.mu.Lock()
+			call := c.calls[callid]
+			delete(c.calls, callid)
+			if call != nil && call.Channel != "" && call.UID != "" {
+				delete(c.calls, call.Channel+":"+call.UID)
+			}
+			c.mu.Unlock()
+			if call == nil {
+				call = &Call{CallID: callid}
+			}
+			call.State = "hangup"
+			call.HangupReason = ReasonServerLost
+			if h != nil {
+				h.OnCallHangup(call)
+			}
+
+		case "unknown":
+			call := &Call{CallID: callid, State: "incoming"}
+			applyResyncFields(call, entry)
+			c.mu.Lock()
+			c.calls[callid] = call
+			c.mu.Unlock()
+			if h != nil {
+				// The far end already claimed this call before the outage;
+				// the return value has nowhere to go, so it's informational only.
+				h.OnCallIncoming(call)
+			}
+		}
+	}
+
+	c.mu.Lock()
+	var stale []*Call
+	for id, call := range c.calls {
+		if call.CallID == id && !seen[id] {
+			stale = append(stale, call)
+			delete(c.calls, id)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, call := range stale {
+		call.State = "hangup"
+		call.HangupReason = ReasonServerLost
+		if h != nil {
+			h.OnCallHangup(call)
+		}
+	}
+
+	return nil
+}
+
+// recordSeq updates the last-seen server event sequence number from an
+// inbound message, so a subsequent reconnect can ask the server to replay
+// anything sent after it. Not every message type carries a "seq" field
+// (command responses don't), so a missing or non-numeric field is silently
+// ignored rather than treated as a reset to zero.
+func (c *Client) recordSeq(parsed map[string]interface{}) {
+	seq, ok := parsed["seq"].(float64)
+	if !ok {
+		return
+	}
+	for {
+		cur := c.lastSeq.Load()
+		if int64(seq) <= cur {
+			return
+		}
+		if c.lastSeq.CompareAndSwap(cur, int64(seq)) {
+			return
+		}
+	}
+}
+
+func applyResyncFields(call *Call, entry map[string]interface{}) {
+	if v, ok := entry["channel"].(string); ok && v != "" {
+		call.Channel = v
+	}
+	if v, ok := entry["uid"].(string); ok && v != "" {
+		call.UID = v
+	}
+	if v, ok := entry["from"].(string); ok && v != "" {
+		call.From = v
+	}
+	if v, ok := entry["to"].(string); ok && v != "" {
+		call.To = v
+	}
+	if v, ok := entry["direction"].(string); ok && v != "" {
+		call.Direction = v
+	}
+	if v, ok := entry["appid"].(string); ok && v != "" {
+		call.AppID = v
+	}
+	if v, ok := entry["state"].(string); ok && v != "" {
+		call.State = v
+	}
+}
@@ -0,0 +1,167 @@
+package telephony
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// ErrNotReady is returned instead of retrying when the connection is
+// currently in TransientFailure — retrying into a backing-off connection
+// just burns the caller's deadline, so callers get a typed error they can
+// branch on (e.g. to wait on WaitForStateChange before trying again).
+var ErrNotReady = errors.New("telephony: connection not ready (TransientFailure)")
+
+// RetryPolicy configures automatic retries for a single command. The zero
+// value means "do not retry" (MaxAttempts treated as 1).
+//
+// Dial is non-idempotent at the gateway, so it is not retried at all
+// unless the caller supplies WithIdempotencyKey, which is echoed back as
+// request_id on every attempt so the server can deduplicate. Safe,
+// naturally idempotent commands (Subscribe, Unbridge) retry on
+// "command timeout" and "connection lost" by default even without an
+// explicit policy.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values <= 1 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff time.Duration
+	// Multiplier grows the backoff after each attempt. Defaults to 2 if <= 1.
+	Multiplier float64
+	// RetryableErrors lists the error strings that may be retried. A nil
+	// slice falls back to {"command timeout", "connection lost"}.
+	RetryableErrors []string
+}
+
+var defaultSafeRetryPolicy = RetryPolicy{
+	MaxAttempts:     3,
+	InitialBackoff:  200 * time.Millisecond,
+	MaxBackoff:      2 * time.Second,
+	Multiplier:      2,
+	RetryableErrors: []string{"command timeout", "connection lost"},
+}
+
+var defaultNoRetryPolicy = RetryPolicy{MaxAttempts: 1}
+
+func (p RetryPolicy) retryable(err error) bool {
+	errs := p.RetryableErrors
+	if errs == nil {
+		errs = defaultSafeRetryPolicy.RetryableErrors
+	}
+	msg := err.Error()
+	for _, e := range errs {
+		if msg == e {
+			return true
+		}
+	}
+	return false
+}
+
+func (p RetryPolicy) nextBackoff(cur time.Duration) time.Duration {
+	mult := p.Multiplier
+	if mult <= 1 {
+		mult = 2
+	}
+	max := p.MaxBackoff
+	if max <= 0 {
+		max = defaultSafeRetryPolicy.MaxBackoff
+	}
+	next := time.Duration(float64(cur) * mult)
+	if next > max {
+		next = max
+	}
+	return next
+}
+
+// jitter returns d plus up to 20% random jitter, so a batch of callers
+// retrying at once doesn't all wake in lockstep.
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return d + time.Duration(rand.Int63n(int64(d)/5+1))
+}
+
+// proxyAwareOpts appends options forcing a single, longer-timeout attempt
+// when a Transport/proxy is in effect — overriding any caller-supplied
+// WithRetryPolicy, since the proxy on the other end already coalesces
+// retries of its own. A no-op when no Transport/proxy is configured.
+func (c *Client) proxyAwareOpts(opts []CallOption) []CallOption {
+	if !c.usingProxy.Load() {
+		return opts
+	}
+	return append(append([]CallOption{}, opts...),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 1}),
+		withCommandTimeout(proxyCommandTimeout),
+	)
+}
+
+// invoke runs action through sendCommand, retrying per the resolved
+// RetryPolicy. safe marks actions that are naturally idempotent
+// (Subscribe, Unbridge) and therefore eligible for a built-in default
+// retry policy even without an explicit WithRetryPolicy option.
+func (c *Client) invoke(ctx context.Context, action string, msg map[string]interface{}, safe bool, opts ...CallOption) (map[string]interface{}, error) {
+	o := applyCallOptions(opts)
+
+	policy := o.retryPolicy
+	if policy == nil {
+		if safe {
+			p := defaultSafeRetryPolicy
+			policy = &p
+		} else {
+			p := defaultNoRetryPolicy
+			policy = &p
+		}
+	}
+
+	// Retrying a non-idempotent command is only safe if the server can
+	// deduplicate it via a stable request_id.
+	if !safe && o.idempotencyKey == "" && policy.MaxAttempts > 1 {
+		p := defaultNoRetryPolicy
+		policy = &p
+	}
+
+	backoff := policy.InitialBackoff
+	if backoff <= 0 {
+		backoff = defaultSafeRetryPolicy.InitialBackoff
+	}
+
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if !o.waitForReady && c.state.get() == TransientFailure {
+			return nil, ErrNotReady
+		}
+
+		if err := c.waitRateLimit(ctx, action); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.sendCommand(ctx, action, msg, opts...)
+		if err == nil {
+			return resp, nil
+		}
+		lastErr = err
+
+		if attempt == maxAttempts || !policy.retryable(err) {
+			return nil, err
+		}
+
+		select {
+		case <-time.After(jitter(backoff)):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		backoff = policy.nextBackoff(backoff)
+	}
+
+	return nil, lastErr
+}
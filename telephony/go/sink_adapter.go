@@ -0,0 +1,59 @@
+package telephony
+
+import "time"
+
+// SinkAdapter implements EventHandler by converting each callback into an
+// Event and forwarding it to every registered EventSink, so an operator can
+// plug a sink straight into SetHandler instead of writing a full
+// EventHandler implementation. Embed it in your own handler type and
+// override the methods you need call-level logic for (e.g. OnCallIncoming
+// to decide whether to claim a call) — the embedded methods still emit to
+// the sinks.
+type SinkAdapter struct {
+	Sinks []EventSink
+}
+
+// NewSinkAdapter returns a SinkAdapter forwarding to sinks.
+func NewSinkAdapter(sinks ...EventSink) *SinkAdapter {
+	return &SinkAdapter{Sinks: sinks}
+}
+
+func (a *SinkAdapter) emit(e Event) {
+	for _, s := range a.Sinks {
+		s.HandleEvent(e)
+	}
+}
+
+func (a *SinkAdapter) callEvent(kind string, call *Call) Event {
+	return Event{Kind: kind, CallID: call.CallID, Channel: call.Channel, From: call.From, To: call.To, Timestamp: time.Now().UTC()}
+}
+
+func (a *SinkAdapter) OnConnected(sessionID string) {
+	a.emit(Event{Kind: "connected", Timestamp: time.Now().UTC(), Extra: map[string]interface{}{"session_id": sessionID}})
+}
+
+// OnCallIncoming emits the event and returns false — SinkAdapter only
+// observes calls, it never claims one. Embed and override this method if
+// your handler also needs to accept/reject inbound calls.
+func (a *SinkAdapter) OnCallIncoming(call *Call) bool {
+	a.emit(a.callEvent("call_incoming", call))
+	return false
+}
+
+func (a *SinkAdapter) OnCallRinging(call *Call)  { a.emit(a.callEvent("call_ringing", call)) }
+func (a *SinkAdapter) OnCallAnswered(call *Call) { a.emit(a.callEvent("call_answered", call)) }
+func (a *SinkAdapter) OnBridgeStart(call *Call)  { a.emit(a.callEvent("agora_bridge_start", call)) }
+func (a *SinkAdapter) OnBridgeEnd(call *Call)    { a.emit(a.callEvent("agora_bridge_end", call)) }
+func (a *SinkAdapter) OnCallHangup(call *Call)   { a.emit(a.callEvent("call_hangup", call)) }
+
+func (a *SinkAdapter) OnError(err error) {
+	a.emit(Event{Kind: "error", Timestamp: time.Now().UTC(), Extra: map[string]interface{}{"error": err.Error()}})
+}
+
+// OnDTMFReceived implements DTMFHandler so SinkAdapter picks up DTMF events
+// too when SetHandler is given a SinkAdapter.
+func (a *SinkAdapter) OnDTMFReceived(call *Call, digits string) {
+	e := a.callEvent("dtmf_received", call)
+	e.Extra = map[string]interface{}{"digits": digits}
+	a.emit(e)
+}
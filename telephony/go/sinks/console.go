@@ -0,0 +1,39 @@
+// Package sinks provides telephony.EventSink implementations for common
+// export destinations, so operators can plug Client events straight into a
+// log pipeline instead of hand-rolling a JSON printer in every program.
+package sinks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	telephony "github.com/AgoraIO/telephony-go"
+)
+
+// ConsoleSink writes each Event as a JSON line to Out, in place of the
+// local logEvent helper the example programs used to print events by hand.
+// Fields Event doesn't promote (session_id, digits, ...) appear under
+// "extra" rather than at the top level.
+type ConsoleSink struct {
+	Out io.Writer
+}
+
+// NewConsoleSink returns a ConsoleSink writing to os.Stdout.
+func NewConsoleSink() *ConsoleSink {
+	return &ConsoleSink{Out: os.Stdout}
+}
+
+// HandleEvent implements telephony.EventSink.
+func (s *ConsoleSink) HandleEvent(e telephony.Event) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	out := s.Out
+	if out == nil {
+		out = os.Stdout
+	}
+	fmt.Fprintln(out, string(b))
+}
@@ -0,0 +1,176 @@
+package sinks
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	telephony "github.com/AgoraIO/telephony-go"
+)
+
+// FileSinkOptions configures rotation for a FileSink.
+type FileSinkOptions struct {
+	// MaxSizeMB rotates the active file once it exceeds this size. Zero
+	// disables size-based rotation.
+	MaxSizeMB int
+	// MaxBackups is the number of rotated segments to keep, oldest deleted
+	// first. Zero keeps them all.
+	MaxBackups int
+	// MaxAge deletes rotated segments older than this. Zero keeps them all.
+	MaxAge time.Duration
+}
+
+// FileSink appends each Event as a JSON line to path, rotating to
+// "path.YYYYMMDDHHMMSS.gz" once the active file exceeds MaxSizeMB and
+// pruning old segments beyond MaxBackups / MaxAge. Modeled on
+// telephony.FileLogger's rotation, with rotated segments gzipped since
+// event logs tend to be kept far longer than diagnostic logs.
+type FileSink struct {
+	path string
+	opts FileSinkOptions
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+// NewFileSink opens (or creates) path for appending and returns a FileSink
+// that rotates it per opts.
+func NewFileSink(path string, opts FileSinkOptions) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open event log file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("stat event log file: %w", err)
+	}
+	return &FileSink{path: path, opts: opts, f: f, size: info.Size()}, nil
+}
+
+// HandleEvent implements telephony.EventSink.
+func (s *FileSink) HandleEvent(e telephony.Event) {
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	b := append(raw, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.opts.MaxSizeMB > 0 && s.size+int64(len(b)) > int64(s.opts.MaxSizeMB)*1024*1024 {
+		s.rotateLocked()
+	}
+
+	n, err := s.f.Write(b)
+	if err == nil {
+		s.size += int64(n)
+	}
+}
+
+// rotateLocked renames the active file aside, gzips it in the background,
+// and opens a fresh one. Callers must hold s.mu.
+func (s *FileSink) rotateLocked() {
+	s.f.Close()
+
+	backup := s.path + "." + time.Now().UTC().Format("20060102150405")
+	os.Rename(s.path, backup)
+	go gzipAndRemove(backup)
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		// Best effort: fall back to appending to the renamed file rather
+		// than losing event output entirely.
+		f, _ = os.OpenFile(backup, os.O_WRONLY|os.O_APPEND, 0o644)
+	}
+	s.f = f
+	s.size = 0
+
+	s.pruneLocked()
+}
+
+// gzipAndRemove compresses path to path+".gz" and removes the original,
+// run in its own goroutine so a large segment doesn't stall HandleEvent.
+func gzipAndRemove(path string) {
+	in, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer in.Close()
+
+	out, err := os.Create(path + ".gz")
+	if err != nil {
+		return
+	}
+	defer out.Close()
+
+	gw := gzip.NewWriter(out)
+	if _, err := io.Copy(gw, in); err != nil {
+		gw.Close()
+		os.Remove(path + ".gz")
+		return
+	}
+	if err := gw.Close(); err != nil {
+		os.Remove(path + ".gz")
+		return
+	}
+	os.Remove(path)
+}
+
+// pruneLocked deletes rotated segments beyond MaxBackups / older than
+// MaxAge. Callers must hold s.mu.
+func (s *FileSink) pruneLocked() {
+	if s.opts.MaxBackups <= 0 && s.opts.MaxAge <= 0 {
+		return
+	}
+
+	dir := filepath.Dir(s.path)
+	prefix := filepath.Base(s.path) + "."
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	var backups []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasPrefix(e.Name(), prefix) {
+			backups = append(backups, filepath.Join(dir, e.Name()))
+		}
+	}
+	sort.Strings(backups) // timestamp prefix sorts chronologically, .gz suffix or not
+
+	if s.opts.MaxAge > 0 {
+		cutoff := time.Now().Add(-s.opts.MaxAge)
+		kept := backups[:0]
+		for _, b := range backups {
+			if info, err := os.Stat(b); err == nil && info.ModTime().Before(cutoff) {
+				os.Remove(b)
+				continue
+			}
+			kept = append(kept, b)
+		}
+		backups = kept
+	}
+
+	if s.opts.MaxBackups > 0 && len(backups) > s.opts.MaxBackups {
+		for _, b := range backups[:len(backups)-s.opts.MaxBackups] {
+			os.Remove(b)
+		}
+	}
+}
+
+// Close flushes and closes the underlying file.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
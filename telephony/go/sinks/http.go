@@ -0,0 +1,179 @@
+package sinks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	telephony "github.com/AgoraIO/telephony-go"
+)
+
+// HTTPSinkOptions configures batching and retry for an HTTPSink.
+type HTTPSinkOptions struct {
+	// BatchSize flushes once this many events have queued. Defaults to 50.
+	BatchSize int
+	// FlushInterval flushes on a timer even if BatchSize isn't reached.
+	// Defaults to 5s.
+	FlushInterval time.Duration
+	// MaxAttempts is the number of POSTs attempted per batch before it is
+	// dropped and logged. Defaults to 3.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. Defaults to 500ms.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries. Defaults to 10s.
+	MaxBackoff time.Duration
+	// HTTPClient performs the POST. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// Logger receives an error (Errorf) when a batch is dropped after
+	// MaxAttempts.
+	// Defaults to telephony.NopLogger{}.
+	Logger telephony.Logger
+}
+
+// HTTPSink batches Events and POSTs them as a JSON array to a collector
+// URL, retrying a failed batch with exponential backoff before dropping it.
+type HTTPSink struct {
+	url  string
+	opts HTTPSinkOptions
+
+	mu   sync.Mutex
+	buf  []telephony.Event
+	kick chan struct{}
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewHTTPSink starts an HTTPSink POSTing batched events to url. Call Close
+// to flush any buffered events and stop its background flush loop.
+func NewHTTPSink(url string, opts HTTPSinkOptions) *HTTPSink {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 50
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = 5 * time.Second
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 3
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = 500 * time.Millisecond
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 10 * time.Second
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+	if opts.Logger == nil {
+		opts.Logger = telephony.NopLogger{}
+	}
+
+	s := &HTTPSink{
+		url:  url,
+		opts: opts,
+		kick: make(chan struct{}, 1),
+		done: make(chan struct{}),
+	}
+	s.wg.Add(1)
+	go s.loop()
+	return s
+}
+
+// HandleEvent implements telephony.EventSink.
+func (s *HTTPSink) HandleEvent(e telephony.Event) {
+	s.mu.Lock()
+	s.buf = append(s.buf, e)
+	full := len(s.buf) >= s.opts.BatchSize
+	s.mu.Unlock()
+
+	if full {
+		select {
+		case s.kick <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *HTTPSink) loop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.opts.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			s.flush()
+			return
+		case <-ticker.C:
+			s.flush()
+		case <-s.kick:
+			s.flush()
+		}
+	}
+}
+
+func (s *HTTPSink) flush() {
+	s.mu.Lock()
+	batch := s.buf
+	s.buf = nil
+	s.mu.Unlock()
+
+	if len(batch) == 0 {
+		return
+	}
+
+	backoff := s.opts.InitialBackoff
+	var lastErr error
+	for attempt := 1; attempt <= s.opts.MaxAttempts; attempt++ {
+		if err := s.post(batch); err != nil {
+			lastErr = err
+			if attempt == s.opts.MaxAttempts {
+				break
+			}
+			time.Sleep(backoff)
+			backoff *= 2
+			if backoff > s.opts.MaxBackoff {
+				backoff = s.opts.MaxBackoff
+			}
+			continue
+		}
+		return
+	}
+
+	s.opts.Logger.Errorf("HTTPSink: dropping batch of %d events after %d attempts: %v", len(batch), s.opts.MaxAttempts, lastErr)
+}
+
+func (s *HTTPSink) post(batch []telephony.Event) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("marshal batch: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.opts.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("post batch: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
+
+// Close flushes any buffered events and stops the background flush loop.
+func (s *HTTPSink) Close() error {
+	close(s.done)
+	s.wg.Wait()
+	return nil
+}
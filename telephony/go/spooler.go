@@ -0,0 +1,333 @@
+package telephony
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// defaultRetryDelay is used when a Job doesn't set RetryDelay.
+const defaultRetryDelay = 30 * time.Second
+
+// defaultSpoolRingTimeout bounds the dial context for a Job that doesn't set
+// Timeout. Dial derives the ring timeout from the context deadline whenever
+// Timeout is empty (see Dial's AutoTimeout handling), so this is the ring
+// duration such a Job gets — set Timeout explicitly on the Job for anything
+// longer.
+const defaultSpoolRingTimeout = 120 * time.Second
+
+// dialRoundTripBuffer pads a Job's own Timeout so the dial context outlives
+// the requested ring duration by enough margin for the command round trip.
+const dialRoundTripBuffer = 10 * time.Second
+
+// jobDialTimeout returns the context timeout to dial job under: the Job's
+// own Timeout plus round-trip margin when set, or defaultSpoolRingTimeout
+// otherwise.
+func jobDialTimeout(job *Job) time.Duration {
+	if job.Timeout != "" {
+		if secs, err := strconv.Atoi(job.Timeout); err == nil && secs > 0 {
+			return time.Duration(secs)*time.Second + dialRoundTripBuffer
+		}
+	}
+	return defaultSpoolRingTimeout
+}
+
+// SpoolerOptions configures a Spooler.
+type SpoolerOptions struct {
+	// Dir is the spool root. NewSpooler creates staging/, queue/, done/,
+	// and failed/ subdirectories under it if they don't already exist.
+	Dir string
+	// PollInterval is how often the Spooler re-scans staging/ for new job
+	// files and queue/ for jobs that are now due. Defaults to 1s.
+	PollInterval time.Duration
+	// OnBridged, if set, is called when a call the Spooler placed reaches
+	// agora_bridge_start, with the Job's Application/Data hints. The
+	// Spooler doesn't interpret Application itself (play a prompt, forward
+	// DTMF to a webhook, ...) — that's left to the caller. Delivered at
+	// most once per job, best-effort: it relies on Client.Events(), so it
+	// only fires if nothing else has already drained that channel dry.
+	OnBridged func(callID, application, data string)
+}
+
+// Spooler watches a filesystem directory for job files describing
+// outbound calls and submits them through Client.Dial as the client comes
+// online — a drop-in job queue for batch dialers (notification blasts,
+// wake-up calls) modeled on Asterisk's outgoing call spool. A job file
+// placed in Dir/staging/ (by hand, or via Submit) is atomically renamed
+// into Dir/queue/ on the next sweep (a file still being written at that
+// moment is moved as it is), dialed
+// once its NotBefore has passed and the Client is Ready, retried per
+// MaxRetries/RetryDelay on failure, and finally moved to Dir/done/ or
+// Dir/failed/.
+type Spooler struct {
+	client    *Client
+	dir       string
+	poll      time.Duration
+	onBridged func(callID, application, data string)
+
+	stopOnce sync.Once
+	done     chan struct{}
+	wg       sync.WaitGroup
+
+	mu       sync.Mutex
+	attempts map[string]*jobAttempt // queue/ filename -> retry state
+
+	hintMu sync.Mutex
+	hints  map[string]jobHint // callid -> Application/Data, until bridged or the Spooler stops
+}
+
+type jobAttempt struct {
+	count       int
+	nextAttempt time.Time
+}
+
+type jobHint struct {
+	application string
+	data        string
+}
+
+// NewSpooler creates a Spooler dialing through client, creating
+// opts.Dir's staging/queue/done/failed subdirectories if they don't
+// already exist. Call Start to begin processing.
+func NewSpooler(client *Client, opts SpoolerOptions) (*Spooler, error) {
+	if client == nil {
+		return nil, errors.New("spooler: client is required")
+	}
+	if opts.Dir == "" {
+		return nil, errors.New("spooler: Dir is required")
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+
+	for _, sub := range []string{"staging", "queue", "done", "failed"} {
+		if err := os.MkdirAll(filepath.Join(opts.Dir, sub), 0o755); err != nil {
+			return nil, fmt.Errorf("spooler: create %s: %w", sub, err)
+		}
+	}
+
+	return &Spooler{
+		client:    client,
+		dir:       opts.Dir,
+		poll:      opts.PollInterval,
+		onBridged: opts.OnBridged,
+		done:      make(chan struct{}),
+		attempts:  make(map[string]*jobAttempt),
+		hints:     make(map[string]jobHint),
+	}, nil
+}
+
+func (s *Spooler) stagingDir() string { return filepath.Join(s.dir, "staging") }
+func (s *Spooler) queueDir() string   { return filepath.Join(s.dir, "queue") }
+func (s *Spooler) doneDir() string    { return filepath.Join(s.dir, "done") }
+func (s *Spooler) failedDir() string  { return filepath.Join(s.dir, "failed") }
+
+// Submit enqueues job programmatically — equivalent to hand-writing a JSON
+// job file into Dir/staging/. Returns the filename assigned in queue/ once
+// picked up, for correlating log output.
+func (s *Spooler) Submit(job Job) (string, error) {
+	b, err := json.Marshal(job)
+	if err != nil {
+		return "", fmt.Errorf("spooler: marshal job: %w", err)
+	}
+	name := fmt.Sprintf("job-%d.json", time.Now().UTC().UnixNano())
+	if err := os.WriteFile(filepath.Join(s.stagingDir(), name), b, 0o644); err != nil {
+		return "", fmt.Errorf("spooler: write job: %w", err)
+	}
+	return name, nil
+}
+
+// Start begins polling staging/ and queue/ in the background, plus (if
+// OnBridged is set) watching Client.Events() for bridge events. Call Stop
+// to shut it down.
+func (s *Spooler) Start() {
+	s.wg.Add(1)
+	go s.loop()
+
+	if s.onBridged != nil {
+		s.wg.Add(1)
+		go s.watchBridges()
+	}
+}
+
+// Stop signals the background goroutines to exit and waits for them.
+func (s *Spooler) Stop() {
+	s.stopOnce.Do(func() { close(s.done) })
+	s.wg.Wait()
+}
+
+func (s *Spooler) loop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.poll)
+	defer ticker.Stop()
+
+	for {
+		s.sweepStaging()
+		s.sweepQueue()
+
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweepStaging atomically moves every file in staging/ into queue/ — a
+// same-filesystem os.Rename, so queue/ never holds a partly copied file.
+// The rename does not wait for a writer: a file still being appended to
+// in staging/ is moved, and later read, in whatever state it is in.
+func (s *Spooler) sweepStaging() {
+	entries, err := os.ReadDir(s.stagingDir())
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		src := filepath.Join(s.stagingDir(), e.Name())
+		dst := filepath.Join(s.queueDir(), e.Name())
+		if err := os.Rename(src, dst); err != nil {
+			s.client.getLogger().Warnf("spooler: stage %s: %v", e.Name(), err)
+		}
+	}
+}
+
+func (s *Spooler) sweepQueue() {
+	entries, err := os.ReadDir(s.queueDir())
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		s.processQueued(e.Name())
+	}
+}
+
+// processQueued dials a single queued job if it's due and the Client is
+// Ready, moving it to done/ on success or failed/ once the first attempt
+// and all MaxRetries redials have failed. A job that isn't due yet, or whose backoff hasn't
+// elapsed, or while the Client isn't Ready, is left in queue/ for the next
+// sweep.
+func (s *Spooler) processQueued(name string) {
+	path := filepath.Join(s.queueDir(), name)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	job, err := ParseJob(data)
+	if err != nil {
+		s.client.getLogger().Warnf("spooler: %s: %v", name, err)
+		s.moveTo(path, s.failedDir(), name)
+		return
+	}
+
+	if !job.NotBefore.IsZero() && time.Now().Before(job.NotBefore) {
+		return
+	}
+
+	s.mu.Lock()
+	at := s.attempts[name]
+	if at == nil {
+		at = &jobAttempt{}
+		s.attempts[name] = at
+	}
+	due := !time.Now().Before(at.nextAttempt)
+	s.mu.Unlock()
+	if !due {
+		return
+	}
+
+	if s.client.State() != Ready {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), jobDialTimeout(job))
+	result, err := s.client.Dial(ctx, DialParams{
+		To: job.To, From: job.From, Channel: job.Channel, UID: job.UID,
+		Token: job.Token, Region: job.Region, Sip: job.Sip, Timeout: job.Timeout,
+	})
+	cancel()
+	if err == nil && !result.Success {
+		err = fmt.Errorf("dial not successful — no gateways available")
+	}
+
+	if err == nil {
+		s.mu.Lock()
+		delete(s.attempts, name)
+		s.mu.Unlock()
+		if job.Application != "" && result.CallID != "" {
+			s.hintMu.Lock()
+			s.hints[result.CallID] = jobHint{application: job.Application, data: job.Data}
+			s.hintMu.Unlock()
+		}
+		s.moveTo(path, s.doneDir(), name)
+		return
+	}
+
+	s.mu.Lock()
+	at.count++
+	exhausted := at.count > job.MaxRetries
+	if !exhausted {
+		delay := job.RetryDelay
+		if delay <= 0 {
+			delay = defaultRetryDelay
+		}
+		at.nextAttempt = time.Now().Add(delay)
+	}
+	attempt := at.count
+	s.mu.Unlock()
+
+	s.client.getLogger().Warnf("spooler: dial failed: %v (%s)", err, fields("job", name, "attempt", attempt))
+
+	if exhausted {
+		s.mu.Lock()
+		delete(s.attempts, name)
+		s.mu.Unlock()
+		s.moveTo(path, s.failedDir(), name)
+	}
+}
+
+func (s *Spooler) moveTo(src, dir, name string) {
+	if err := os.Rename(src, filepath.Join(dir, name)); err != nil {
+		s.client.getLogger().Warnf("spooler: move %s to %s: %v", name, dir, err)
+	}
+}
+
+// watchBridges delivers OnBridged for jobs that have a recorded
+// Application hint once their call reaches agora_bridge_start.
+func (s *Spooler) watchBridges() {
+	defer s.wg.Done()
+
+	events := s.client.Events()
+	for {
+		select {
+		case <-s.done:
+			return
+		case e := <-events:
+			if e.Kind != "agora_bridge_start" || e.CallID == "" {
+				continue
+			}
+			s.hintMu.Lock()
+			hint, ok := s.hints[e.CallID]
+			if ok {
+				delete(s.hints, e.CallID)
+			}
+			s.hintMu.Unlock()
+			if ok {
+				s.onBridged(e.CallID, hint.application, hint.data)
+			}
+		}
+	}
+}
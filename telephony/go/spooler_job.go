@@ -0,0 +1,194 @@
+package telephony
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Job describes one outbound call for a Spooler to place, mirroring the
+// fields Asterisk's call-file convention uses plus a few scheduling and
+// post-bridge hints of our own.
+type Job struct {
+	To      string
+	From    string
+	Channel string
+	UID     string
+	Token   string
+	Region  string
+	Sip     string
+	Timeout string
+
+	// NotBefore delays the call until this time has passed. The zero value
+	// means "as soon as the client is Ready".
+	NotBefore time.Time
+	// MaxRetries is the number of redial attempts after the first failure.
+	MaxRetries int
+	// RetryDelay is how long the Spooler waits before redialing a failed
+	// job. Zero falls back to 30s.
+	RetryDelay time.Duration
+
+	// Application and Data describe what to do once the call bridges —
+	// e.g. "play_prompt"/"welcome.wav" or "forward_dtmf"/webhook URL. The
+	// Spooler doesn't interpret these itself; see SpoolerOptions.OnBridged.
+	Application string
+	Data        string
+}
+
+// jobJSON mirrors Job with string-typed NotBefore/RetryDelay so both parse
+// as plain text (RFC3339, Go duration syntax) instead of JSON's native
+// number-of-nanoseconds encoding for time.Duration.
+type jobJSON struct {
+	To      string `json:"To"`
+	From    string `json:"From"`
+	Channel string `json:"Channel"`
+	UID     string `json:"UID"`
+	Token   string `json:"Token"`
+	Region  string `json:"Region"`
+	Sip     string `json:"Sip"`
+	Timeout string `json:"Timeout"`
+
+	NotBefore  string `json:"NotBefore,omitempty"`
+	MaxRetries int    `json:"MaxRetries,omitempty"`
+	RetryDelay string `json:"RetryDelay,omitempty"`
+
+	Application string `json:"Application,omitempty"`
+	Data        string `json:"Data,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler so Job round-trips through
+// Spooler.Submit with RFC3339/duration-string formatting.
+func (j Job) MarshalJSON() ([]byte, error) {
+	raw := jobJSON{
+		To: j.To, From: j.From, Channel: j.Channel, UID: j.UID,
+		Token: j.Token, Region: j.Region, Sip: j.Sip, Timeout: j.Timeout,
+		MaxRetries:  j.MaxRetries,
+		Application: j.Application, Data: j.Data,
+	}
+	if !j.NotBefore.IsZero() {
+		raw.NotBefore = j.NotBefore.UTC().Format(time.RFC3339)
+	}
+	if j.RetryDelay > 0 {
+		raw.RetryDelay = j.RetryDelay.String()
+	}
+	return json.Marshal(raw)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, the counterpart to MarshalJSON.
+func (j *Job) UnmarshalJSON(data []byte) error {
+	var raw jobJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*j = Job{
+		To: raw.To, From: raw.From, Channel: raw.Channel, UID: raw.UID,
+		Token: raw.Token, Region: raw.Region, Sip: raw.Sip, Timeout: raw.Timeout,
+		MaxRetries:  raw.MaxRetries,
+		Application: raw.Application, Data: raw.Data,
+	}
+	if raw.NotBefore != "" {
+		t, err := time.Parse(time.RFC3339, raw.NotBefore)
+		if err != nil {
+			return fmt.Errorf("invalid NotBefore: %w", err)
+		}
+		j.NotBefore = t
+	}
+	if raw.RetryDelay != "" {
+		d, err := time.ParseDuration(raw.RetryDelay)
+		if err != nil {
+			return fmt.Errorf("invalid RetryDelay: %w", err)
+		}
+		j.RetryDelay = d
+	}
+	return nil
+}
+
+// ParseJob decodes a job file's contents — JSON if it starts with '{',
+// otherwise Asterisk-style "Key: Value" text, one field per line.
+func ParseJob(data []byte) (Job, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return Job{}, errors.New("job: empty file")
+	}
+
+	var job Job
+	var err error
+	if trimmed[0] == '{' {
+		err = json.Unmarshal(trimmed, &job)
+	} else {
+		job, err = parseCallFile(trimmed)
+	}
+	if err != nil {
+		return Job{}, err
+	}
+	if job.To == "" {
+		return Job{}, errors.New("job: missing To")
+	}
+	return job, nil
+}
+
+// parseCallFile parses the Asterisk call-file-style "Key: Value" text
+// format. Blank lines and lines starting with ";" or "#" are ignored;
+// unrecognized keys are ignored too, so a job file can carry extra
+// operator notes.
+func parseCallFile(data []byte) (Job, error) {
+	var job Job
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, val, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		val = strings.TrimSpace(val)
+
+		switch key {
+		case "To":
+			job.To = val
+		case "From":
+			job.From = val
+		case "Channel":
+			job.Channel = val
+		case "UID":
+			job.UID = val
+		case "Token":
+			job.Token = val
+		case "Region":
+			job.Region = val
+		case "Sip":
+			job.Sip = val
+		case "Timeout":
+			job.Timeout = val
+		case "Application":
+			job.Application = val
+		case "Data":
+			job.Data = val
+		case "NotBefore":
+			t, err := time.Parse(time.RFC3339, val)
+			if err != nil {
+				return Job{}, fmt.Errorf("invalid NotBefore: %w", err)
+			}
+			job.NotBefore = t
+		case "MaxRetries":
+			n, err := strconv.Atoi(val)
+			if err != nil {
+				return Job{}, fmt.Errorf("invalid MaxRetries: %w", err)
+			}
+			job.MaxRetries = n
+		case "RetryDelay":
+			d, err := time.ParseDuration(val)
+			if err != nil {
+				return Job{}, fmt.Errorf("invalid RetryDelay: %w", err)
+			}
+			job.RetryDelay = d
+		}
+	}
+	return job, nil
+}
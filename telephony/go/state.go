@@ -0,0 +1,135 @@
+package telephony
+
+import (
+	"context"
+	"sync"
+)
+
+// ConnectivityState represents the state of the Client's connection to the
+// CM WebSocket endpoint, modeled on gRPC's clientconn state machine
+// (google.golang.org/grpc/connectivity).
+type ConnectivityState int
+
+const (
+	// Idle means the client has been constructed but Connect has not yet
+	// been called.
+	Idle ConnectivityState = iota
+	// Connecting means the client is dialing, registering, or re-registering
+	// after a dropped connection.
+	Connecting
+	// Ready means the connection is registered and commands can be sent.
+	Ready
+	// TransientFailure means the last dial/register attempt failed, or an
+	// established connection was lost; the client is backing off before
+	// the next reconnect attempt.
+	TransientFailure
+	// Shutdown means Close has been called. The client will not reconnect
+	// and the state will never change again.
+	Shutdown
+)
+
+// String returns the gRPC-style upper-snake-case name of the state.
+func (s ConnectivityState) String() string {
+	switch s {
+	case Idle:
+		return "IDLE"
+	case Connecting:
+		return "CONNECTING"
+	case Ready:
+		return "READY"
+	case TransientFailure:
+		return "TRANSIENT_FAILURE"
+	case Shutdown:
+		return "SHUTDOWN"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// connectivityStateMgr tracks the Client's current ConnectivityState and
+// notifies watchers on every transition. It mirrors the pubsub used
+// internally by grpc-go's ClientConn: a channel that is closed (and
+// replaced) on each transition lets WaitForStateChange wake without
+// polling, while registered watcher channels get an explicit push.
+type connectivityStateMgr struct {
+	mu       sync.Mutex
+	state    ConnectivityState
+	notifyCh chan struct{}
+	watchers map[chan<- ConnectivityState]struct{}
+}
+
+func newConnectivityStateMgr() *connectivityStateMgr {
+	return &connectivityStateMgr{
+		notifyCh: make(chan struct{}),
+		watchers: make(map[chan<- ConnectivityState]struct{}),
+	}
+}
+
+// set transitions to s and wakes any waiters, unless the manager has
+// already shut down or is already in s.
+func (m *connectivityStateMgr) set(s ConnectivityState) {
+	m.mu.Lock()
+	if m.state == Shutdown || m.state == s {
+		m.mu.Unlock()
+		return
+	}
+	m.state = s
+	watchers := make([]chan<- ConnectivityState, 0, len(m.watchers))
+	for ch := range m.watchers {
+		watchers = append(watchers, ch)
+	}
+	close(m.notifyCh)
+	m.notifyCh = make(chan struct{})
+	m.mu.Unlock()
+
+	for _, ch := range watchers {
+		select {
+		case ch <- s:
+		default:
+		}
+	}
+}
+
+func (m *connectivityStateMgr) get() ConnectivityState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.state
+}
+
+// notify registers ch to receive every subsequent transition. Sends are
+// non-blocking, so a slow watcher misses intermediate states rather than
+// stalling the client — callers that need every transition should give ch
+// enough buffer to keep up.
+func (m *connectivityStateMgr) notify(ch chan<- ConnectivityState) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.watchers[ch] = struct{}{}
+}
+
+// stopNotify unregisters a watcher previously passed to notify.
+func (m *connectivityStateMgr) stopNotify(ch chan<- ConnectivityState) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.watchers, ch)
+}
+
+// waitForChange blocks until the state differs from current, ctx is done,
+// or the manager shuts down, then returns the state observed at wake time.
+func (m *connectivityStateMgr) waitForChange(ctx context.Context, current ConnectivityState) (ConnectivityState, error) {
+	for {
+		m.mu.Lock()
+		state := m.state
+		ch := m.notifyCh
+		m.mu.Unlock()
+
+		if state != current {
+			return state, nil
+		}
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return state, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package telephony
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+
+	"golang.org/x/net/proxy"
+)
+
+// Transport dials the underlying connection for the events WebSocket,
+// letting callers route it through an HTTP CONNECT proxy, a SOCKS5
+// gateway, or a fully custom net.Conn — useful for corporate egress
+// policies and for tunneling telephony traffic across a mesh.
+type Transport interface {
+	DialContext(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+// TransportFunc adapts a plain dial func to the Transport interface.
+type TransportFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// DialContext calls f.
+func (f TransportFunc) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	return f(ctx, network, addr)
+}
+
+// WithTransport routes the events WebSocket connection through t instead of
+// a direct dial. A dial func set by WithNetDialContext or WithProxyDialer
+// still takes precedence if one is set, but WithTransport otherwise wins
+// over the TELEPHONY_PROXY/HTTPS_PROXY/ALL_PROXY env lookups. Set it before Connect;
+// reconnects reuse the same Transport.
+//
+// Because a Transport's own proxy or tunnel typically already coalesces
+// retries on its end, Dial and Accept fall back to a single attempt with a
+// longer timeout while a Transport is in effect, regardless of any
+// WithRetryPolicy passed to the call.
+func WithTransport(t Transport) ClientOption {
+	return func(c *Client) {
+		c.transport = t
+	}
+}
+
+// WithTLSConfig sets the *tls.Config used for the wss:// handshake, e.g. to
+// pin a private CA or present a client certificate for enterprise
+// deployments.
+func WithTLSConfig(cfg *tls.Config) ClientOption {
+	return func(c *Client) {
+		c.tlsConfig = cfg
+	}
+}
+
+// WithNetDialContext overrides the dial func used to establish the
+// underlying TCP connection before the TLS/WebSocket handshake — e.g. a
+// pinned net.Conn for tests, or a hand-rolled proxy chain. Takes precedence
+// over WithTransport and the HTTPS_PROXY/ALL_PROXY env lookup. It sets the
+// same dial func as WithProxyDialer, so of those two the option applied
+// last wins.
+func WithNetDialContext(dial func(ctx context.Context, network, addr string) (net.Conn, error)) ClientOption {
+	return func(c *Client) {
+		c.netDialContext = dial
+	}
+}
+
+// WithProxyDialer routes the connection through a golang.org/x/net/proxy
+// Dialer (SOCKS5, or any custom chain). If d also implements
+// proxy.ContextDialer its DialContext is used directly so ctx cancellation
+// is honored; otherwise the blocking Dial call races against ctx.
+func WithProxyDialer(d proxy.Dialer) ClientOption {
+	return func(c *Client) {
+		c.netDialContext = dialContextFrom(d)
+	}
+}
+
+// WithoutEnvProxy disables the default HTTPS_PROXY/ALL_PROXY environment
+// lookup Connect performs when no explicit NetDialContext/ProxyDialer has
+// been set.
+func WithoutEnvProxy() ClientOption {
+	return func(c *Client) {
+		c.noEnvProxy = true
+	}
+}
+
+// resolveDialContext returns the NetDialContext Connect should hand the
+// websocket.Dialer: an explicit override if one was set, otherwise the
+// configured/discovered Transport, otherwise (unless opted out) whatever
+// HTTPS_PROXY / ALL_PROXY describe, otherwise nil to fall back to the
+// websocket package's default net.Dialer. Also records whether the result
+// is a Transport or an env-discovered proxy, so Dial/Accept can back off
+// their retry behavior. A dial func from WithNetDialContext or
+// WithProxyDialer is not recorded as a proxy, so Dial/Accept keep their
+// normal retry behavior with it.
+func (c *Client) resolveDialContext() func(context.Context, string, string) (net.Conn, error) {
+	if c.netDialContext != nil {
+		return c.netDialContext
+	}
+	if c.transport != nil {
+		c.usingProxy.Store(true)
+		return c.transport.DialContext
+	}
+	if c.noEnvProxy {
+		return nil
+	}
+	if t, err := transportFromEnv(); err != nil {
+		c.getLogger().Warnf("ignoring TELEPHONY_PROXY/TELEPHONY_PROXY_ADDRESS: %v", err)
+	} else if t != nil {
+		c.usingProxy.Store(true)
+		return t.DialContext
+	}
+	if raw := firstNonEmpty(os.Getenv("HTTPS_PROXY"), os.Getenv("https_proxy")); raw != "" {
+		if u, err := url.Parse(raw); err == nil {
+			c.usingProxy.Store(true)
+			return httpConnectDialContext(u)
+		}
+	}
+	if raw := firstNonEmpty(os.Getenv("ALL_PROXY"), os.Getenv("all_proxy")); raw != "" {
+		c.usingProxy.Store(true)
+		return dialContextFrom(proxy.FromEnvironment())
+	}
+	return nil
+}
+
+// transportFromEnv builds a Transport from TELEPHONY_PROXY or
+// TELEPHONY_PROXY_ADDRESS (checked in that order), mirroring how other Go
+// network clients discover a proxy from the environment. A "socks5://" or
+// "socks5h://" scheme dials through a SOCKS5 gateway; anything else is
+// tunneled via HTTP CONNECT. Returns a nil Transport if neither var is set.
+func transportFromEnv() (Transport, error) {
+	raw := firstNonEmpty(os.Getenv("TELEPHONY_PROXY"), os.Getenv("TELEPHONY_PROXY_ADDRESS"))
+	if raw == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parse proxy URL: %w", err)
+	}
+	switch u.Scheme {
+	case "socks5", "socks5h":
+		d, err := proxy.FromURL(u, proxy.Direct)
+		if err != nil {
+			return nil, fmt.Errorf("socks5 dialer: %w", err)
+		}
+		return TransportFunc(dialContextFrom(d)), nil
+	default:
+		return TransportFunc(httpConnectDialContext(u)), nil
+	}
+}
+
+func firstNonEmpty(vals ...string) string {
+	for _, v := range vals {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// dialContextFrom adapts a proxy.Dialer to a context-aware dial func.
+func dialContextFrom(d proxy.Dialer) func(context.Context, string, string) (net.Conn, error) {
+	if cd, ok := d.(proxy.ContextDialer); ok {
+		return cd.DialContext
+	}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		// d.Dial blocks synchronously; race it against ctx so callers still
+		// get deadline semantics from a Dialer implementation that
+		// predates context.Context.
+		type result struct {
+			conn net.Conn
+			err  error
+		}
+		ch := make(chan result, 1)
+		go func() {
+			conn, err := d.Dial(network, addr)
+			ch <- result{conn, err}
+		}()
+		select {
+		case r := <-ch:
+			return r.conn, r.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// httpConnectDialContext tunnels through an HTTP CONNECT proxy at
+// proxyURL, handing back the raw pre-TLS conn — the websocket.Dialer
+// performs the TLS handshake on top of it, same as it would for a direct
+// connection.
+func httpConnectDialContext(proxyURL *url.URL) func(context.Context, string, string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, network, proxyURL.Host)
+		if err != nil {
+			return nil, fmt.Errorf("proxy dial failed: %w", err)
+		}
+
+		connectReq := &http.Request{
+			Method: http.MethodConnect,
+			URL:    &url.URL{Opaque: addr},
+			Host:   addr,
+			Header: make(http.Header),
+		}
+		if user := proxyURL.User; user != nil {
+			pass, _ := user.Password()
+			auth := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + pass))
+			connectReq.Header.Set("Proxy-Authorization", "Basic "+auth)
+		}
+
+		if err := connectReq.Write(conn); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("proxy CONNECT write failed: %w", err)
+		}
+
+		resp, err := http.ReadResponse(bufio.NewReader(conn), connectReq)
+		if err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("proxy CONNECT response failed: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			conn.Close()
+			return nil, fmt.Errorf("proxy CONNECT failed: %s", resp.Status)
+		}
+		return conn, nil
+	}
+}